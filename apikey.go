@@ -0,0 +1,230 @@
+package atoll
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"strings"
+)
+
+// Base62Alphabet is the alphabet made of digits, uppercase and lowercase letters.
+const Base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// ChecksumAlgorithm represents the algorithm used to compute the checksum of API keys.
+type ChecksumAlgorithm uint8
+
+// Checksum algorithms.
+const (
+	// CRC32 is the IEEE CRC-32 checksum, used by GitHub tokens.
+	CRC32 ChecksumAlgorithm = iota
+	// CRC32C is the Castagnoli CRC-32 checksum.
+	CRC32C
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// APIKey represents an API key with an identifiable prefix and a checksum, like
+// acme_live_9VB8MkZ0ZcNpUXjvyS7ZKdyr7M9SiL03INcC.
+//
+// The prefix lets secret scanners find leaked keys and the checksum lets services reject malformed
+// keys offline, without looking them up in a database.
+type APIKey struct {
+	// Identifies the issuer of the key, like "acme". It can contain only letters and digits.
+	Prefix string
+	// Environment tag, like "live" or "test". It's optional and can contain only letters and digits.
+	Environment string
+	// Number of random characters of the body, 30 by default.
+	Length uint64
+	// Characters of the body and the checksum, Base62Alphabet by default. It cannot contain
+	// underscores.
+	Alphabet string
+	// Algorithm used to compute the checksum of the key.
+	Checksum ChecksumAlgorithm
+}
+
+// APIKeyParts holds the parts of an API key.
+type APIKeyParts struct {
+	Prefix      string
+	Environment string
+	Body        string
+	Checksum    string
+}
+
+// Generate generates a random API key.
+func (k *APIKey) Generate() ([]byte, error) {
+	key, err := k.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return key, nil
+}
+
+func (k *APIKey) generate() ([]byte, error) {
+	if err := k.validateParams(); err != nil {
+		return nil, err
+	}
+
+	length, alphabet := k.params()
+	key := make([]byte, 0, len(k.Prefix)+len(k.Environment)+length+8)
+	key = append(key, k.Prefix...)
+	key = append(key, '_')
+	if k.Environment != "" {
+		key = append(key, k.Environment...)
+		key = append(key, '_')
+	}
+	for i := 0; i < length; i++ {
+		key = append(key, alphabet[randInt(len(alphabet))])
+	}
+
+	return append(key, k.checksum(key)...), nil
+}
+
+// Entropy returns the API key entropy in bits, only the random body is taken into account as the
+// prefix, the environment and the checksum are deterministic.
+func (k *APIKey) Entropy() float64 {
+	length, alphabet := k.params()
+	return float64(length) * math.Log2(float64(len(alphabet)))
+}
+
+// ParseAPIKey splits the key into its parts and verifies that it matches the format and that its
+// checksum is valid.
+//
+// If the format has no environment, keys with any environment are accepted.
+func ParseAPIKey(key []byte, format *APIKey) (*APIKeyParts, error) {
+	if err := format.validateParams(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	segments := strings.Split(string(key), "_")
+	parts := &APIKeyParts{Prefix: segments[0]}
+	switch len(segments) {
+	case 2:
+	case 3:
+		parts.Environment = segments[1]
+	default:
+		return nil, errors.New("atoll: invalid API key format")
+	}
+
+	if parts.Prefix != format.Prefix {
+		return nil, fmt.Errorf("atoll: expected prefix %q, got %q", format.Prefix, parts.Prefix)
+	}
+	if format.Environment != "" && parts.Environment != format.Environment {
+		return nil, fmt.Errorf("atoll: expected environment %q, got %q", format.Environment, parts.Environment)
+	}
+
+	length, alphabet := format.params()
+	rest := segments[len(segments)-1]
+	width := checksumWidth(len(alphabet))
+	if len(rest) != length+width {
+		return nil, fmt.Errorf("atoll: expected %d characters after the prefix, got %d", length+width, len(rest))
+	}
+	if i := strings.IndexFunc(rest, func(r rune) bool { return !strings.ContainsRune(alphabet, r) }); i != -1 {
+		return nil, fmt.Errorf("atoll: invalid character %q", rest[i])
+	}
+
+	parts.Body, parts.Checksum = rest[:length], rest[length:]
+	if err := VerifyChecksum(key, format); err != nil {
+		return nil, err
+	}
+
+	return parts, nil
+}
+
+// VerifyChecksum checks that the last characters of the key are the checksum of the rest of it.
+func VerifyChecksum(key []byte, format *APIKey) error {
+	if err := format.validateParams(); err != nil {
+		return fmt.Errorf("atoll: %w", err)
+	}
+
+	_, alphabet := format.params()
+	width := checksumWidth(len(alphabet))
+	if len(key) < width {
+		return errors.New("atoll: API key is too short")
+	}
+
+	payload, checksum := key[:len(key)-width], key[len(key)-width:]
+	if format.checksum(payload) != string(checksum) {
+		return errors.New("atoll: invalid API key checksum")
+	}
+
+	return nil
+}
+
+// checksum returns the checksum of the payload encoded with the alphabet.
+func (k *APIKey) checksum(payload []byte) string {
+	_, alphabet := k.params()
+
+	var sum uint32
+	switch k.Checksum {
+	case CRC32C:
+		sum = crc32.Checksum(payload, castagnoliTable)
+	default:
+		sum = crc32.ChecksumIEEE(payload)
+	}
+
+	// Fixed width encoding, most significant character first
+	n := uint32(len(alphabet))
+	encoded := make([]byte, checksumWidth(len(alphabet)))
+	for i := len(encoded) - 1; i >= 0; i-- {
+		encoded[i] = alphabet[sum%n]
+		sum /= n
+	}
+	return string(encoded)
+}
+
+// checksumWidth returns the number of characters of an alphabet of n characters required to encode
+// a 32 bit checksum.
+func checksumWidth(n int) int {
+	width := 0
+	for capacity := uint64(1); capacity < 1<<32; capacity *= uint64(n) {
+		width++
+	}
+	return width
+}
+
+// params returns the body length and the alphabet with their defaults.
+func (k *APIKey) params() (length int, alphabet string) {
+	length, alphabet = 30, Base62Alphabet
+	if k.Length != 0 {
+		length = int(k.Length)
+	}
+	if k.Alphabet != "" {
+		alphabet = k.Alphabet
+	}
+
+	return length, alphabet
+}
+
+func (k *APIKey) validateParams() error {
+	if k.Prefix == "" {
+		return errors.New("API key prefix is required")
+	}
+
+	if strings.Trim(k.Prefix, Base62Alphabet) != "" {
+		return fmt.Errorf("prefix %q can contain only letters and digits", k.Prefix)
+	}
+	if strings.Trim(k.Environment, Base62Alphabet) != "" {
+		return fmt.Errorf("environment %q can contain only letters and digits", k.Environment)
+	}
+
+	_, alphabet := k.params()
+	if len(alphabet) < 2 {
+		return errors.New("the alphabet must have at least two characters")
+	}
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] > 127 || alphabet[i] <= ' ' || alphabet[i] == '_' {
+			return fmt.Errorf("alphabet contains invalid characters: %q", alphabet[i])
+		}
+		if strings.IndexByte(alphabet[i+1:], alphabet[i]) != -1 {
+			return fmt.Errorf("alphabet character %q is repeated", alphabet[i])
+		}
+	}
+
+	if k.Checksum > CRC32C {
+		return fmt.Errorf("invalid checksum algorithm: %d", k.Checksum)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package atoll
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAPIKey(t *testing.T) {
+	cases := []*APIKey{
+		{Prefix: "acme", Environment: "live"},
+		{Prefix: "acme", Environment: "test", Length: 40, Checksum: CRC32C},
+		{Prefix: "ghp", Length: 20, Alphabet: Crockford},
+	}
+
+	for _, k := range cases {
+		key, err := k.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		expectedPrefix := k.Prefix + "_"
+		if k.Environment != "" {
+			expectedPrefix += k.Environment + "_"
+		}
+		if !strings.HasPrefix(string(key), expectedPrefix) {
+			t.Errorf("Expected %q to start with %q", key, expectedPrefix)
+		}
+
+		parts, err := ParseAPIKey(key, k)
+		if err != nil {
+			t.Fatalf("ParseAPIKey() failed: %v", err)
+		}
+		length, alphabet := k.params()
+		if len(parts.Body) != length || len(parts.Checksum) != checksumWidth(len(alphabet)) {
+			t.Errorf("Invalid parts: %+v", parts)
+		}
+		if got := parts.Prefix + "_" + parts.Environment; k.Environment != "" && got+"_"+parts.Body+parts.Checksum != string(key) {
+			t.Errorf("Parts %+v don't match %q", parts, key)
+		}
+
+		if err := VerifyChecksum(key, k); err != nil {
+			t.Errorf("VerifyChecksum() failed: %v", err)
+		}
+	}
+}
+
+func TestAPIKeyChecksum(t *testing.T) {
+	cases := []struct {
+		k        *APIKey
+		payload  string
+		expected string
+	}{
+		// Check values of the CRC-32 algorithms
+		{k: &APIKey{Alphabet: "0123456789abcdef"}, payload: "123456789", expected: "cbf43926"},
+		{k: &APIKey{Alphabet: "0123456789abcdef", Checksum: CRC32C}, payload: "123456789", expected: "e3069283"},
+		{k: &APIKey{}, payload: "acme_test_0000000000", expected: "4TZ6Ro"},
+	}
+
+	for _, tc := range cases {
+		if got := tc.k.checksum([]byte(tc.payload)); got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+
+	format := &APIKey{Prefix: "acme", Environment: "test", Length: 10}
+	key := []byte("acme_test_00000000004TZ6Ro")
+	if _, err := ParseAPIKey(key, format); err != nil {
+		t.Errorf("ParseAPIKey() failed: %v", err)
+	}
+
+	// Any single character change must be detected
+	for i := range key {
+		typo := []byte(string(key))
+		typo[i] ^= 1
+		if VerifyChecksum(typo, format) == nil {
+			t.Errorf("Expected %q to be invalid", typo)
+		}
+	}
+}
+
+func TestParseAPIKeyErrors(t *testing.T) {
+	format := &APIKey{Prefix: "acme", Environment: "test", Length: 10}
+	cases := map[string]string{
+		"format":      "acme_test_extra_00000000004TZ6Ro",
+		"prefix":      "acne_test_00000000004TZ6Ro",
+		"environment": "acme_live_00000000004TZ6Ro",
+		"length":      "acme_test_0000000004TZ6Ro",
+		"character":   "acme_test_000000000-4TZ6Ro",
+		"checksum":    "acme_test_00000000004TZ6Rp",
+	}
+
+	for name, key := range cases {
+		if _, err := ParseAPIKey([]byte(key), format); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	// Formats without environment accept any of them
+	format.Environment = ""
+	if _, err := ParseAPIKey([]byte(cases["environment"]), format); err == nil {
+		t.Error("Expected checksum error, got nil")
+	}
+	if _, err := ParseAPIKey([]byte("acme_test_00000000004TZ6Ro"), format); err != nil {
+		t.Errorf("ParseAPIKey() failed: %v", err)
+	}
+}
+
+func TestAPIKeyEntropy(t *testing.T) {
+	k := &APIKey{Prefix: "acme", Environment: "live"}
+	expected := 30 * math.Log2(62)
+	if got := k.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestInvalidAPIKey(t *testing.T) {
+	cases := map[string]*APIKey{
+		"missing prefix":    {},
+		"invalid prefix":    {Prefix: "ac_me"},
+		"invalid env":       {Prefix: "acme", Environment: "li-ve"},
+		"short alphabet":    {Prefix: "acme", Alphabet: "a"},
+		"underscore":        {Prefix: "acme", Alphabet: "ab_"},
+		"repeated":          {Prefix: "acme", Alphabet: "aba"},
+		"invalid algorithm": {Prefix: "acme", Checksum: 5},
+	}
+
+	for name, k := range cases {
+		if _, err := k.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+		if err := VerifyChecksum([]byte("acme_0000000000000000"), k); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
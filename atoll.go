@@ -23,6 +23,9 @@ func NewSecret(secret Secret) ([]byte, error) {
 
 // Keyspace returns the set of all possible permutations of the generated key (poolLength ^ keyLength).
 //
+// Secrets with a variable length report an entropy weighted by the probability of each length,
+// so the keyspace is the effective one rather than the sum of the keyspaces of every length.
+//
 // On average, half the key space must be searched to find the solution (keyspace/2).
 func Keyspace(secret Secret) float64 {
 	return math.Pow(2, secret.Entropy())
@@ -0,0 +1,316 @@
+package atoll
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// Crockford is the Crockford Base32 alphabet, which excludes I, L, O and U to avoid confusions.
+const Crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// CheckAlgorithm represents the algorithm used to compute the check symbol of a code.
+type CheckAlgorithm uint8
+
+// Check algorithms.
+const (
+	// NoCheck doesn't add a check symbol.
+	NoCheck CheckAlgorithm = iota
+	// LuhnCheck uses the Luhn mod N algorithm, it detects every single symbol error and most
+	// transpositions of adjacent symbols.
+	LuhnCheck
+	// DammCheck uses the Damm algorithm, it detects every single symbol error and every transposition
+	// of adjacent symbols. It requires an alphabet whose length is a prime number, a power of two or 10.
+	DammCheck
+)
+
+// dammDecimal is the quasigroup of order 10 of the original Damm algorithm.
+var dammDecimal = [10][10]byte{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// irreduciblePolys holds an irreducible polynomial of degree k for GF(2^k), indexed by k.
+var irreduciblePolys = [...]int{2: 0b111, 3: 0b1011, 4: 0b10011, 5: 0b100101, 6: 0b1000011, 7: 0b10000011}
+
+// typoSubstitutes maps characters commonly mistyped to the ones that were probably meant, they
+// are applied only when the character is not part of the alphabet.
+var typoSubstitutes = map[byte]byte{'O': '0', 'I': '1', 'L': '1', 'U': 'V'}
+
+// Code represents a random code made to be typed by hand, like invite codes or license keys:
+// 4Z9K-7QWM-3XHB.
+//
+// Codes are case-insensitive unless the alphabet contains both lowercase and uppercase letters, and
+// Normalize corrects common typos like O instead of 0.
+type Code struct {
+	// Number of random symbols of the code, not counting the check symbol.
+	Length uint64
+	// Symbols the code is made of, Crockford Base32 by default.
+	Alphabet string
+	// Algorithm used to compute a check symbol appended to the code.
+	Check CheckAlgorithm
+	// Number of symbols of each group, the code isn't grouped if it's zero.
+	GroupSize uint64
+	// Delimiter between groups, "-" by default.
+	Delimiter string
+}
+
+// NewCode returns a random code of the given length using the Crockford Base32 alphabet.
+func NewCode(length uint64) ([]byte, error) {
+	c := &Code{
+		Length: length,
+	}
+
+	return c.Generate()
+}
+
+// Generate generates a random code.
+func (c *Code) Generate() ([]byte, error) {
+	code, err := c.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return code, nil
+}
+
+func (c *Code) generate() ([]byte, error) {
+	if err := c.validateParams(); err != nil {
+		return nil, err
+	}
+
+	alphabet := c.alphabet()
+	code := make([]byte, c.Length, c.Length+1)
+	for i := range code {
+		code[i] = alphabet[randInt(len(alphabet))]
+	}
+
+	if c.Check != NoCheck {
+		check, err := c.checkSymbol(code)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, check)
+	}
+
+	if c.GroupSize == 0 {
+		return code, nil
+	}
+
+	g := &Grouping{Size: c.GroupSize, Delimiter: c.delimiter()}
+	return g.Format(code)
+}
+
+// Entropy returns the code entropy in bits, the check symbol adds none.
+func (c *Code) Entropy() float64 {
+	return float64(c.Length) * math.Log2(float64(len(c.alphabet())))
+}
+
+// Normalize returns the code without delimiters or white space, with the letter case of the
+// alphabet and common typos corrected: O becomes 0, I and L become 1 and U becomes V when they are
+// not part of the alphabet.
+func (c *Code) Normalize(code []byte) []byte {
+	alphabet := c.alphabet()
+	upperOnly := !strings.ContainsAny(alphabet, string(Lower))
+	lowerOnly := !strings.ContainsAny(alphabet, string(Upper))
+
+	if !strings.ContainsAny(c.delimiter(), alphabet) {
+		code = bytes.ReplaceAll(code, []byte(c.delimiter()), nil)
+	}
+
+	normalized := make([]byte, 0, len(code))
+	for _, s := range code {
+		switch {
+		case strings.IndexByte(alphabet, s) != -1:
+		case s == ' ' || s == '\t' || s == '\n' || s == '-':
+			continue
+		case upperOnly:
+			s = upper(s)
+		case lowerOnly:
+			s = lower(s)
+		}
+
+		if strings.IndexByte(alphabet, s) == -1 {
+			if sub, ok := typoSubstitutes[upper(s)]; ok {
+				switch {
+				case strings.IndexByte(alphabet, sub) != -1:
+					s = sub
+				case strings.IndexByte(alphabet, lower(sub)) != -1:
+					s = lower(sub)
+				}
+			}
+		}
+		normalized = append(normalized, s)
+	}
+
+	return normalized
+}
+
+// Verify checks that the code is valid after normalizing it: it has the right length, it's made of
+// symbols of the alphabet and its check symbol matches.
+func (c *Code) Verify(code []byte) error {
+	if err := c.validateParams(); err != nil {
+		return fmt.Errorf("atoll: %w", err)
+	}
+
+	normalized := c.Normalize(code)
+	length := int(c.Length)
+	if c.Check != NoCheck {
+		length++
+	}
+	if len(normalized) != length {
+		return fmt.Errorf("atoll: expected %d symbols, got %d", length, len(normalized))
+	}
+
+	alphabet := c.alphabet()
+	for _, s := range normalized {
+		if strings.IndexByte(alphabet, s) == -1 {
+			return fmt.Errorf("atoll: invalid symbol %q", s)
+		}
+	}
+
+	if c.Check == NoCheck {
+		return nil
+	}
+
+	check, err := c.checkSymbol(normalized[:c.Length])
+	if err != nil {
+		return fmt.Errorf("atoll: %w", err)
+	}
+	if check != normalized[c.Length] {
+		return errors.New("atoll: invalid check symbol")
+	}
+
+	return nil
+}
+
+// checkSymbol returns the check symbol of the code.
+func (c *Code) checkSymbol(code []byte) (byte, error) {
+	alphabet := c.alphabet()
+	if c.Check == LuhnCheck {
+		return luhnModN(alphabet, code)
+	}
+
+	interim := 0
+	for _, s := range code {
+		interim = dammOp(len(alphabet), interim, strings.IndexByte(alphabet, s))
+	}
+
+	// The check symbol is the one that takes the interim value to zero
+	for i := range alphabet {
+		if dammOp(len(alphabet), interim, i) == 0 {
+			return alphabet[i], nil
+		}
+	}
+	return 0, errors.New("no check symbol found")
+}
+
+// alphabet returns the alphabet used.
+func (c *Code) alphabet() string {
+	if c.Alphabet == "" {
+		return Crockford
+	}
+	return c.Alphabet
+}
+
+// delimiter returns the delimiter between groups.
+func (c *Code) delimiter() string {
+	if c.Delimiter == "" {
+		return "-"
+	}
+	return c.Delimiter
+}
+
+func (c *Code) validateParams() error {
+	if c.Length < 1 {
+		return errors.New("code length must be equal to or higher than 1")
+	}
+
+	alphabet := c.alphabet()
+	if len(alphabet) < 2 {
+		return errors.New("the alphabet must have at least two symbols")
+	}
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] > 127 || alphabet[i] <= ' ' {
+			return fmt.Errorf("alphabet contains invalid characters: %q", alphabet[i])
+		}
+		if strings.IndexByte(alphabet[i+1:], alphabet[i]) != -1 {
+			return fmt.Errorf("alphabet symbol %q is repeated", alphabet[i])
+		}
+	}
+
+	if c.GroupSize != 0 && strings.ContainsAny(c.delimiter(), alphabet) {
+		return errors.New("delimiter cannot contain symbols of the alphabet")
+	}
+
+	switch c.Check {
+	case NoCheck, LuhnCheck:
+	case DammCheck:
+		if !dammSupported(len(alphabet)) {
+			return fmt.Errorf("damm check requires an alphabet of a prime, a power of two or 10 symbols, got %d", len(alphabet))
+		}
+	default:
+		return fmt.Errorf("invalid check algorithm: %d", c.Check)
+	}
+
+	return nil
+}
+
+// dammSupported returns whether there's a totally anti-symmetric quasigroup of order n available.
+func dammSupported(n int) bool {
+	switch {
+	case n == 10:
+		return true
+	case n&(n-1) == 0:
+		k := bits.Len(uint(n)) - 1
+		return k < len(irreduciblePolys) && irreduciblePolys[k] != 0
+	default:
+		return n > 2 && isPrime(n)
+	}
+}
+
+// dammOp returns x∘y in a totally anti-symmetric quasigroup of order n.
+//
+// Except for the decimal table of the original algorithm, the operation is x∘y = 2x + y in the
+// finite field of n elements, which is totally anti-symmetric because 2 is not 0 or 1 in it.
+func dammOp(n, x, y int) int {
+	if n == 10 {
+		return int(dammDecimal[x][y])
+	}
+
+	if n&(n-1) == 0 {
+		// Multiply by the polynomial x in GF(2^k) and add without carries
+		k := bits.Len(uint(n)) - 1
+		x <<= 1
+		if x >= n {
+			x ^= irreduciblePolys[k]
+		}
+		return x ^ y
+	}
+
+	return (2*x + y) % n
+}
+
+// isPrime returns whether n is a prime number.
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for i := 2; i*i <= n; i++ {
+		if n%i == 0 {
+			return false
+		}
+	}
+	return true
+}
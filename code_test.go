@@ -0,0 +1,202 @@
+package atoll
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	cases := []*Code{
+		{Length: 12},
+		{Length: 12, Check: LuhnCheck, GroupSize: 4},
+		{Length: 10, Check: DammCheck, GroupSize: 5, Delimiter: " "},
+		{Length: 8, Alphabet: string(Digit), Check: DammCheck},
+		{Length: 8, Alphabet: "abcdefghijkmnpqrstuvwxyz2345678", Check: DammCheck, GroupSize: 4},
+	}
+
+	for _, c := range cases {
+		for i := 0; i < 20; i++ {
+			code, err := c.Generate()
+			if err != nil {
+				t.Fatalf("Generate() failed: %v", err)
+			}
+
+			normalized := c.Normalize(code)
+			expected := int(c.Length)
+			if c.Check != NoCheck {
+				expected++
+			}
+			if len(normalized) != expected {
+				t.Errorf("Expected %d symbols, got %q", expected, code)
+			}
+			if c.GroupSize != 0 && !strings.Contains(string(code), c.delimiter()) {
+				t.Errorf("Expected %q to be grouped", code)
+			}
+
+			if err := c.Verify(code); err != nil {
+				t.Errorf("Verify(%q) failed: %v", code, err)
+			}
+		}
+	}
+}
+
+func TestCodeNormalize(t *testing.T) {
+	c := &Code{Length: 8, GroupSize: 4}
+	cases := map[string]string{
+		"4z9k-7qwm":   "4Z9K7QWM",
+		"oil0-uL1V":   "0110V11V",
+		" 4Z9K 7QWM ": "4Z9K7QWM",
+	}
+
+	for code, expected := range cases {
+		if got := string(c.Normalize([]byte(code))); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+
+	// Lowercase alphabets keep the substitutions in lowercase
+	c = &Code{Length: 4, Alphabet: "0123456789abcdef"}
+	if got := string(c.Normalize([]byte("DEAD-bOO1"))); got != "deadb001" {
+		t.Errorf("Expected %q, got %q", "deadb001", got)
+	}
+}
+
+func TestCodeVerifyTypos(t *testing.T) {
+	cases := []*Code{
+		{Length: 9, Check: DammCheck},
+		{Length: 9, Check: LuhnCheck},
+		{Length: 9, Alphabet: "ABCDEFGHJKMNPQRSTVWXYZ234567890", Check: DammCheck}, // 31 symbols
+		{Length: 9, Alphabet: string(Digit), Check: DammCheck},
+	}
+
+	for _, c := range cases {
+		code, err := c.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		alphabet := c.alphabet()
+
+		// Single symbol errors
+		for i := range code {
+			for j := 0; j < len(alphabet); j++ {
+				if alphabet[j] == code[i] {
+					continue
+				}
+				typo := []byte(string(code))
+				typo[i] = alphabet[j]
+				if c.Verify(typo) == nil {
+					t.Errorf("Expected %q to be invalid (original %q)", typo, code)
+				}
+			}
+		}
+
+		// Transpositions of adjacent symbols, Luhn misses a few of them
+		if c.Check != DammCheck {
+			continue
+		}
+		for i := 0; i < len(code)-1; i++ {
+			if code[i] == code[i+1] {
+				continue
+			}
+			typo := []byte(string(code))
+			typo[i], typo[i+1] = typo[i+1], typo[i]
+			if c.Verify(typo) == nil {
+				t.Errorf("Expected %q to be invalid (original %q)", typo, code)
+			}
+		}
+	}
+}
+
+func TestDammDecimal(t *testing.T) {
+	c := &Code{Length: 3, Alphabet: string(Digit), Check: DammCheck}
+	check, err := c.checkSymbol([]byte("572"))
+	if err != nil {
+		t.Fatalf("checkSymbol() failed: %v", err)
+	}
+	if check != '4' {
+		t.Errorf("Expected '4', got %q", check)
+	}
+}
+
+func TestDammQuasigroups(t *testing.T) {
+	for _, n := range []int{3, 4, 5, 8, 10, 16, 31, 32, 64, 128} {
+		if !dammSupported(n) {
+			t.Fatalf("Expected order %d to be supported", n)
+		}
+
+		// Rows and columns must be permutations and the operation totally anti-symmetric
+		for x := 0; x < n; x++ {
+			row, col := make(map[int]bool), make(map[int]bool)
+			for y := 0; y < n; y++ {
+				row[dammOp(n, x, y)] = true
+				col[dammOp(n, y, x)] = true
+			}
+			if len(row) != n || len(col) != n {
+				t.Fatalf("Order %d is not a quasigroup", n)
+			}
+		}
+		for c := 0; c < n; c++ {
+			for x := 0; x < n; x++ {
+				for y := 0; y < n; y++ {
+					if x != y && dammOp(n, dammOp(n, c, x), y) == dammOp(n, dammOp(n, c, y), x) {
+						t.Fatalf("Order %d is not totally anti-symmetric", n)
+					}
+				}
+			}
+		}
+	}
+
+	for _, n := range []int{2, 6, 12, 256} {
+		if dammSupported(n) {
+			t.Errorf("Expected order %d to be unsupported", n)
+		}
+	}
+}
+
+func TestCodeEntropy(t *testing.T) {
+	c := &Code{Length: 10, Check: DammCheck}
+	if got := c.Entropy(); got != 50 {
+		t.Errorf("Expected 50, got %f", got)
+	}
+
+	c = &Code{Length: 4, Alphabet: string(Digit)}
+	expected := 4 * math.Log2(10)
+	if got := c.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestInvalidCode(t *testing.T) {
+	cases := map[string]*Code{
+		"length":           {},
+		"short alphabet":   {Length: 4, Alphabet: "A"},
+		"repeated symbol":  {Length: 4, Alphabet: "ABCA"},
+		"invalid symbol":   {Length: 4, Alphabet: "AB C"},
+		"delimiter":        {Length: 4, GroupSize: 2, Delimiter: "A"},
+		"damm unsupported": {Length: 4, Alphabet: "ABCDEF", Check: DammCheck},
+		"invalid check":    {Length: 4, Check: 7},
+	}
+	for name, c := range cases {
+		if _, err := c.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	c := &Code{Length: 4, Check: DammCheck}
+	for _, code := range []string{"ABC", "ABCDEF", "AB*D1"} {
+		if err := c.Verify([]byte(code)); err == nil {
+			t.Errorf("Expected %q to be invalid", code)
+		}
+	}
+}
+
+func TestNewCode(t *testing.T) {
+	code, err := NewCode(8)
+	if err != nil {
+		t.Fatalf("NewCode() failed: %v", err)
+	}
+	if len(code) != 8 || strings.Trim(string(code), Crockford) != "" {
+		t.Errorf("Expected 8 Crockford symbols, got %q", code)
+	}
+}
@@ -0,0 +1,289 @@
+package atoll
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// crypt64 is the alphabet of the base64 variant used by crypt(3).
+const crypt64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// Byte order in which SHA-crypt and MD5-crypt encode the final digest.
+var (
+	sha256CryptOrder = []int{
+		0, 10, 20, 21, 1, 11, 12, 22, 2, 3, 13, 23, 24, 4, 14,
+		15, 25, 5, 6, 16, 26, 27, 7, 17, 18, 28, 8, 9, 19, 29, 31, 30,
+	}
+	sha512CryptOrder = []int{
+		0, 21, 42, 22, 43, 1, 44, 2, 23, 3, 24, 45, 25, 46, 4, 47, 5, 26, 6, 27, 48,
+		28, 49, 7, 50, 8, 29, 9, 30, 51, 31, 52, 10, 53, 11, 32, 12, 33, 54, 34, 55, 13,
+		56, 14, 35, 15, 36, 57, 37, 58, 16, 59, 17, 38, 18, 39, 60, 40, 61, 19, 62, 20, 41, 63,
+	}
+	md5CryptOrder = []int{0, 6, 12, 1, 7, 13, 2, 8, 14, 3, 9, 15, 4, 10, 5, 11}
+)
+
+const (
+	shaCryptDefaultRounds = 5000
+	shaCryptMinRounds     = 1000
+	shaCryptMaxRounds     = 999999999
+	shaCryptMaxSalt       = 16
+)
+
+// HashSHA256Crypt returns the SHA-256 crypt ($5$) hash of the password with a random salt, the format
+// used by /etc/shadow. If rounds is 0, the default of 5000 is used and omitted from the hash.
+func HashSHA256Crypt(password []byte, rounds int) (string, error) {
+	return hashSHACrypt("$5$", password, rounds)
+}
+
+// HashSHA512Crypt returns the SHA-512 crypt ($6$) hash of the password with a random salt, the format
+// used by /etc/shadow. If rounds is 0, the default of 5000 is used and omitted from the hash.
+func HashSHA512Crypt(password []byte, rounds int) (string, error) {
+	return hashSHACrypt("$6$", password, rounds)
+}
+
+// VerifySHACrypt reports whether the password matches the SHA-256 ($5$) or SHA-512 ($6$) crypt hash.
+func VerifySHACrypt(password []byte, hash string) (bool, error) {
+	prefix, rest, ok := strings.Cut(strings.TrimPrefix(hash, "$"), "$")
+	if !ok || !strings.HasPrefix(hash, "$") || (prefix != "5" && prefix != "6") {
+		return false, errors.New("atoll: invalid SHA-crypt hash")
+	}
+
+	rounds := 0
+	if r, ok := strings.CutPrefix(rest, "rounds="); ok {
+		value, after, found := strings.Cut(r, "$")
+		n, err := strconv.Atoi(value)
+		if !found || err != nil {
+			return false, errors.New("atoll: invalid SHA-crypt rounds")
+		}
+		rounds, rest = min(max(n, shaCryptMinRounds), shaCryptMaxRounds), after
+	}
+
+	salt, _, ok := strings.Cut(rest, "$")
+	if !ok {
+		return false, errors.New("atoll: invalid SHA-crypt hash")
+	}
+
+	// Compare only the digests, rounds out of range are clamped when computing the hash
+	computed := shaCrypt("$"+prefix+"$", password, []byte(salt), rounds)
+	return cryptDigestEqual(computed, hash), nil
+}
+
+// HashAPR1 returns the Apache MD5 ($apr1$) hash of the password with a random salt, to be written in
+// htpasswd files as user:hash.
+//
+// MD5 is weak, prefer bcrypt if the server supports it.
+func HashAPR1(password []byte) string {
+	return apr1(password, cryptSalt(8))
+}
+
+// VerifyAPR1 reports whether the password matches the Apache MD5 ($apr1$) hash.
+func VerifyAPR1(password []byte, hash string) (bool, error) {
+	rest, ok := strings.CutPrefix(hash, "$apr1$")
+	if !ok {
+		return false, errors.New("atoll: invalid APR1 hash")
+	}
+	salt, _, ok := strings.Cut(rest, "$")
+	if !ok {
+		return false, errors.New("atoll: invalid APR1 hash")
+	}
+
+	return cryptDigestEqual(apr1(password, []byte(salt)), hash), nil
+}
+
+func hashSHACrypt(prefix string, password []byte, rounds int) (string, error) {
+	if rounds != 0 && (rounds < shaCryptMinRounds || rounds > shaCryptMaxRounds) {
+		return "", fmt.Errorf("atoll: rounds must be between %d and %d, got %d", shaCryptMinRounds, shaCryptMaxRounds, rounds)
+	}
+
+	return shaCrypt(prefix, password, cryptSalt(shaCryptMaxSalt), rounds), nil
+}
+
+// shaCrypt computes the SHA-crypt hash as specified by Ulrich Drepper. The prefix selects the hash
+// function and a rounds value of 0 means the default one.
+func shaCrypt(prefix string, password, salt []byte, rounds int) string {
+	newHash, order := sha256.New, sha256CryptOrder
+	if prefix == "$6$" {
+		newHash, order = sha512.New, sha512CryptOrder
+	}
+	if len(salt) > shaCryptMaxSalt {
+		salt = salt[:shaCryptMaxSalt]
+	}
+	customRounds := rounds != 0
+	if !customRounds {
+		rounds = shaCryptDefaultRounds
+	}
+
+	// Digest B: password, salt, password
+	h := newHash()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(password)
+	b := h.Sum(nil)
+
+	// Digest A
+	h.Reset()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(repeatBytes(b, len(password)))
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			h.Write(b)
+		} else {
+			h.Write(password)
+		}
+	}
+	a := h.Sum(nil)
+
+	// Byte sequence P: the password hashed as many times as its length
+	h.Reset()
+	for range password {
+		h.Write(password)
+	}
+	p := repeatBytes(h.Sum(nil), len(password))
+
+	// Byte sequence S: the salt hashed 16 + A[0] times
+	h.Reset()
+	for i := 0; i < 16+int(a[0]); i++ {
+		h.Write(salt)
+	}
+	s := repeatBytes(h.Sum(nil), len(salt))
+
+	c := a
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+		if i%2 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i%2 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(c[:0])
+	}
+
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	if customRounds {
+		sb.WriteString("rounds=" + strconv.Itoa(rounds) + "$")
+	}
+	sb.Write(salt)
+	sb.WriteByte('$')
+	sb.Write(encodeCrypt64(c, order))
+	return sb.String()
+}
+
+// apr1 computes the Apache variant of the MD5-crypt hash.
+func apr1(password, salt []byte) string {
+	const magic = "$apr1$"
+	if len(salt) > 8 {
+		salt = salt[:8]
+	}
+
+	h := md5.New()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(password)
+	final := h.Sum(nil)
+
+	h.Reset()
+	h.Write(password)
+	h.Write([]byte(magic))
+	h.Write(salt)
+	h.Write(repeatBytes(final, len(password)))
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			h.Write([]byte{0})
+		} else {
+			h.Write(password[:1])
+		}
+	}
+	final = h.Sum(final[:0])
+
+	for i := 0; i < 1000; i++ {
+		h.Reset()
+		if i%2 != 0 {
+			h.Write(password)
+		} else {
+			h.Write(final)
+		}
+		if i%3 != 0 {
+			h.Write(salt)
+		}
+		if i%7 != 0 {
+			h.Write(password)
+		}
+		if i%2 != 0 {
+			h.Write(final)
+		} else {
+			h.Write(password)
+		}
+		final = h.Sum(final[:0])
+	}
+
+	return magic + string(salt) + "$" + string(encodeCrypt64(final, md5CryptOrder))
+}
+
+// encodeCrypt64 encodes the digest taking its bytes in the given order, three at a time and with
+// the least significant bits first. The last group may have fewer bytes.
+func encodeCrypt64(digest []byte, order []int) []byte {
+	encoded := make([]byte, 0, (len(digest)*8+5)/6)
+	for i := 0; i < len(order); i += 3 {
+		group := order[i:min(i+3, len(order))]
+		var w uint
+		for _, idx := range group {
+			w = w<<8 | uint(digest[idx])
+		}
+		encoded = appendCrypt64(encoded, w, len(group)+1)
+	}
+	return encoded
+}
+
+// cryptDigestEqual reports whether the digests of the crypt hashes a and b, their last fields, are
+// equal in constant time.
+func cryptDigestEqual(a, b string) bool {
+	a = a[strings.LastIndexByte(a, '$')+1:]
+	b = b[strings.LastIndexByte(b, '$')+1:]
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// appendCrypt64 appends n characters encoding w, least significant bits first.
+func appendCrypt64(dst []byte, w uint, n int) []byte {
+	for i := 0; i < n; i++ {
+		dst = append(dst, crypt64[w&0x3f])
+		w >>= 6
+	}
+	return dst
+}
+
+// cryptSalt returns a random salt of n characters of the crypt64 alphabet.
+func cryptSalt(n int) []byte {
+	salt := make([]byte, n)
+	for i := range salt {
+		salt[i] = crypt64[randInt(len(crypt64))]
+	}
+	return salt
+}
+
+// repeatBytes returns the bytes of b repeated until they are n bytes long.
+func repeatBytes(b []byte, n int) []byte {
+	repeated := make([]byte, 0, n)
+	for len(repeated) < n {
+		repeated = append(repeated, b[:min(len(b), n-len(repeated))]...)
+	}
+	return repeated
+}
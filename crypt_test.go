@@ -0,0 +1,155 @@
+package atoll
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSHACrypt(t *testing.T) {
+	// Vectors of the SHA-crypt specification, checked with openssl passwd
+	cases := []struct {
+		prefix   string
+		password string
+		salt     string
+		rounds   int
+		expected string
+	}{
+		{
+			prefix: "$5$", password: "Hello world!", salt: "saltstring",
+			expected: "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5",
+		},
+		{
+			prefix: "$5$", password: "Hello world!", salt: "saltstringsaltstring", rounds: 10000,
+			expected: "$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA",
+		},
+		{
+			prefix: "$6$", password: "Hello world!", salt: "saltstring",
+			expected: "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		},
+		{
+			prefix:   "$6$",
+			password: "a very much longer text to encrypt.  This one even stretches over morethan one line.",
+			salt:     "anotherlongsaltstring", rounds: 1400,
+			expected: "$6$rounds=1400$anotherlongsalts$POfYwTEok97VWcjxIiSOjiykti.o/pQs.wPvMxQ6Fm7I6IoYN3CmLs66x9t0oSwbtEW7o7UmJEiDwGqd8p4ur1",
+		},
+	}
+
+	for _, tc := range cases {
+		got := shaCrypt(tc.prefix, []byte(tc.password), []byte(tc.salt), tc.rounds)
+		if got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+
+		ok, err := VerifySHACrypt([]byte(tc.password), tc.expected)
+		if err != nil {
+			t.Fatalf("VerifySHACrypt() failed: %v", err)
+		}
+		if !ok {
+			t.Errorf("Expected %q to match %q", tc.password, tc.expected)
+		}
+		if ok, _ := VerifySHACrypt([]byte(tc.password+"!"), tc.expected); ok {
+			t.Errorf("Expected %q not to match %q", tc.password+"!", tc.expected)
+		}
+	}
+
+	// Rounds below the minimum are clamped to 1000
+	ok, err := VerifySHACrypt([]byte("the minimum number is still observed"),
+		"$5$rounds=10$roundstoolow$yfvwcWrQ8l/K0DAWyuPMDNHpIVlTQebY9l/gL972bIC")
+	if err != nil || !ok {
+		t.Errorf("Expected clamped rounds hash to match: %v", err)
+	}
+}
+
+func TestHashSHACrypt(t *testing.T) {
+	password := []byte("s3cr3t-P4ss")
+	cases := []struct {
+		hash   func([]byte, int) (string, error)
+		rounds int
+		prefix string
+	}{
+		{hash: HashSHA256Crypt, prefix: "$5$"},
+		{hash: HashSHA512Crypt, prefix: "$6$"},
+		{hash: HashSHA512Crypt, rounds: 2000, prefix: "$6$rounds=2000$"},
+	}
+
+	for _, tc := range cases {
+		hash, err := tc.hash(password, tc.rounds)
+		if err != nil {
+			t.Fatalf("Hash failed: %v", err)
+		}
+		if !strings.HasPrefix(hash, tc.prefix) {
+			t.Errorf("Expected %q to start with %q", hash, tc.prefix)
+		}
+
+		salt := strings.Split(strings.TrimPrefix(hash, tc.prefix), "$")[0]
+		if len(salt) != 16 || strings.Trim(salt, crypt64) != "" {
+			t.Errorf("Invalid salt %q", salt)
+		}
+
+		if ok, err := VerifySHACrypt(password, hash); err != nil || !ok {
+			t.Errorf("Expected %q to match: %v", hash, err)
+		}
+	}
+
+	for _, rounds := range []int{-1, 999, 1000000000} {
+		if _, err := HashSHA256Crypt(password, rounds); err == nil {
+			t.Errorf("Expected rounds %d error, got nil", rounds)
+		}
+	}
+}
+
+func TestVerifySHACryptErrors(t *testing.T) {
+	cases := map[string]string{
+		"empty":   "",
+		"prefix":  "$1$saltstring$abc",
+		"dollar":  "5$saltstring$abc",
+		"rounds":  "$5$rounds=abc$saltstring$abc",
+		"no salt": "$5$saltstring",
+	}
+
+	for name, hash := range cases {
+		if _, err := VerifySHACrypt([]byte("password"), hash); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
+
+func TestAPR1(t *testing.T) {
+	// Computed with openssl passwd -apr1
+	cases := []struct {
+		password string
+		salt     string
+		expected string
+	}{
+		{password: "password", salt: "r31.....", expected: "$apr1$r31.....$ARC3pREO82RIm0aQ2zszC0"},
+		{password: "Tr0ub4dor&3xyzw!", salt: "abcdefgh", expected: "$apr1$abcdefgh$.rehTzaOoTZqAV.RnycUA1"},
+	}
+
+	for _, tc := range cases {
+		if got := apr1([]byte(tc.password), []byte(tc.salt)); got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+
+		ok, err := VerifyAPR1([]byte(tc.password), tc.expected)
+		if err != nil {
+			t.Fatalf("VerifyAPR1() failed: %v", err)
+		}
+		if !ok {
+			t.Errorf("Expected %q to match %q", tc.password, tc.expected)
+		}
+	}
+
+	hash := HashAPR1([]byte("s3cr3t"))
+	if ok, err := VerifyAPR1([]byte("s3cr3t"), hash); err != nil || !ok {
+		t.Errorf("Expected %q to match: %v", hash, err)
+	}
+	if ok, _ := VerifyAPR1([]byte("s3cr3T"), hash); ok {
+		t.Errorf("Expected %q not to match", hash)
+	}
+
+	for _, invalid := range []string{"$1$r31.....$ARC3pREO82RIm0aQ2zszC0", "$apr1$r31....."} {
+		if _, err := VerifyAPR1([]byte("password"), invalid); err == nil {
+			t.Errorf("Expected %q error, got nil", invalid)
+		}
+	}
+}
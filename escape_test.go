@@ -0,0 +1,165 @@
+package atoll
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"testing"
+)
+
+// escapeSecrets returns a set of secrets containing every character that requires escaping.
+func escapeSecrets(t *testing.T) [][]byte {
+	t.Helper()
+
+	secrets := [][]byte{
+		[]byte(Special),
+		[]byte(`'"'\\''$HOME` + "`id`"),
+		[]byte(" leading and trailing spaces "),
+		[]byte("tab\tnew\nline\rreturn"),
+		[]byte("ünïcödé"),
+	}
+
+	for i := 0; i < 10; i++ {
+		p := &Password{
+			Length: 40,
+			Levels: []Level{Lower, Upper, Digit, Space, Special},
+			Repeat: true,
+		}
+		password, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		secrets = append(secrets, password)
+	}
+
+	return secrets
+}
+
+func TestEscapeShell(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	for _, secret := range escapeSecrets(t) {
+		out, err := exec.Command(sh, "-c", "printf %s "+string(EscapeShell(secret))).Output()
+		if err != nil {
+			t.Fatalf("sh failed: %v", err)
+		}
+
+		if string(out) != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, out)
+		}
+	}
+}
+
+func TestEscapeURLUserinfo(t *testing.T) {
+	for _, secret := range escapeSecrets(t) {
+		escaped := EscapeURLUserinfo(secret)
+		u, err := url.Parse("https://" + string(escaped) + ":" + string(escaped) + "@example.com")
+		if err != nil {
+			t.Fatalf("Failed parsing URL: %v", err)
+		}
+
+		if u.User.Username() != string(secret) {
+			t.Errorf("Expected user %q, got %q", secret, u.User.Username())
+		}
+		if password, _ := u.User.Password(); password != string(secret) {
+			t.Errorf("Expected password %q, got %q", secret, password)
+		}
+	}
+}
+
+func TestEscapeJSON(t *testing.T) {
+	for _, secret := range escapeSecrets(t) {
+		var got string
+		if err := json.Unmarshal(EscapeJSON(secret), &got); err != nil {
+			t.Fatalf("Failed decoding JSON: %v", err)
+		}
+
+		if got != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, got)
+		}
+	}
+}
+
+func TestEscapeYAML(t *testing.T) {
+	for _, secret := range escapeSecrets(t) {
+		// The escape sequences used are a subset of JSON's
+		var got string
+		if err := json.Unmarshal(EscapeYAML(secret), &got); err != nil {
+			t.Fatalf("Failed decoding %s: %v", EscapeYAML(secret), err)
+		}
+
+		if got != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, got)
+		}
+	}
+
+	cases := []struct {
+		secret   string
+		expected string
+	}{
+		{secret: "a\x00\x7f", expected: `"a\u0000\u007f"`},
+		// Supplementary plane characters need the 32 bit escape
+		{secret: "a\U000F0000b", expected: `"a\U000f0000b"`},
+		{secret: "\U0010FFFF", expected: `"\U0010ffff"`},
+	}
+
+	for _, tc := range cases {
+		if got := string(EscapeYAML([]byte(tc.secret))); got != tc.expected {
+			t.Errorf("Expected %s, got %s", tc.expected, got)
+		}
+	}
+}
+
+func TestEscapeXMLAttr(t *testing.T) {
+	for _, secret := range escapeSecrets(t) {
+		var v struct {
+			Value string `xml:"value,attr"`
+		}
+		doc := "<secret value=" + string(EscapeXMLAttr(secret)) + "/>"
+		if err := xml.Unmarshal([]byte(doc), &v); err != nil {
+			t.Fatalf("Failed decoding XML: %v", err)
+		}
+
+		if v.Value != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, v.Value)
+		}
+	}
+}
+
+func TestEscapeEnv(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+
+	for _, secret := range escapeSecrets(t) {
+		script := "SECRET=" + string(EscapeEnv(secret)) + "\nprintf %s \"$SECRET\""
+		out, err := exec.Command(sh, "-c", script).Output()
+		if err != nil {
+			t.Fatalf("sh failed: %v", err)
+		}
+
+		if string(out) != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, out)
+		}
+	}
+}
+
+func TestEscapeGo(t *testing.T) {
+	secrets := append(escapeSecrets(t), []byte("\xff\x00invalid"))
+	for _, secret := range secrets {
+		got, err := strconv.Unquote(string(EscapeGo(secret)))
+		if err != nil {
+			t.Fatalf("Failed unquoting: %v", err)
+		}
+
+		if got != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, got)
+		}
+	}
+}
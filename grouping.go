@@ -0,0 +1,161 @@
+package atoll
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Grouping formats secrets in groups of characters separated by a delimiter so they are easier
+// to read and transcribe: k7Qp-9xZm-2wLr.
+//
+// The formatting isn't part of the secret, it doesn't add entropy and it's removed when parsing.
+type Grouping struct {
+	// Number of characters of each group, 4 by default. The last group may be shorter.
+	Size uint64
+	// Delimiter between groups, "-" by default.
+	Delimiter string
+	// Append a check character to each group computed with the Luhn mod N algorithm, which detects
+	// every single character error and most transpositions of adjacent characters.
+	Check bool
+	// Characters the check characters are computed over, they must include every character of the
+	// secret. Lower, Upper and Digit by default.
+	Alphabet string
+}
+
+// Format returns the secret split in groups.
+func (g *Grouping) Format(secret []byte) ([]byte, error) {
+	size, delimiter, alphabet := g.params()
+	if err := g.validate(alphabet); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	var formatted []byte
+	for i := 0; i < len(secret); i += size {
+		if i > 0 {
+			formatted = append(formatted, delimiter...)
+		}
+
+		group := secret[i:min(i+size, len(secret))]
+		formatted = append(formatted, group...)
+		if g.Check {
+			check, err := luhnModN(alphabet, group)
+			if err != nil {
+				return nil, fmt.Errorf("atoll: %w", err)
+			}
+			formatted = append(formatted, check)
+		}
+	}
+
+	return formatted, nil
+}
+
+// Parse returns the secret without the formatting, verifying the check characters if they are used.
+//
+// Groups are read by their position, so the delimiter may also be part of the secret.
+func (g *Grouping) Parse(formatted []byte) ([]byte, error) {
+	size, delimiter, alphabet := g.params()
+	if err := g.validate(alphabet); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	groupLength := size
+	if g.Check {
+		groupLength++
+	}
+
+	var secret []byte
+	for n := 1; len(formatted) > 0; n++ {
+		group := formatted
+		if len(formatted) > groupLength {
+			group = formatted[:groupLength]
+			formatted = formatted[groupLength:]
+			if !bytes.HasPrefix(formatted, []byte(delimiter)) {
+				return nil, fmt.Errorf("atoll: expected %q after group %d", delimiter, n)
+			}
+			formatted = formatted[len(delimiter):]
+			if len(formatted) == 0 {
+				return nil, errors.New("atoll: trailing delimiter")
+			}
+		} else {
+			formatted = nil
+		}
+
+		if g.Check {
+			if len(group) < 2 {
+				return nil, fmt.Errorf("atoll: group %d is too short", n)
+			}
+			check, err := luhnModN(alphabet, group[:len(group)-1])
+			if err != nil {
+				return nil, fmt.Errorf("atoll: %w", err)
+			}
+			if check != group[len(group)-1] {
+				return nil, fmt.Errorf("atoll: invalid check character in group %d", n)
+			}
+			group = group[:len(group)-1]
+		}
+		secret = append(secret, group...)
+	}
+
+	return secret, nil
+}
+
+// params returns the group size, the delimiter and the alphabet with their defaults.
+func (g *Grouping) params() (size int, delimiter, alphabet string) {
+	size, delimiter, alphabet = 4, "-", string(Lower+Upper+Digit)
+	if g.Size != 0 {
+		size = int(g.Size)
+	}
+	if g.Delimiter != "" {
+		delimiter = g.Delimiter
+	}
+	if g.Alphabet != "" {
+		alphabet = g.Alphabet
+	}
+
+	return size, delimiter, alphabet
+}
+
+func (g *Grouping) validate(alphabet string) error {
+	if !g.Check {
+		return nil
+	}
+
+	if len(alphabet) < 2 {
+		return errors.New("the alphabet must have at least two characters")
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] > 127 {
+			return fmt.Errorf("alphabet contains invalid characters: %q", alphabet[i])
+		}
+		if strings.IndexByte(alphabet[i+1:], alphabet[i]) != -1 {
+			return fmt.Errorf("alphabet character %q is repeated", alphabet[i])
+		}
+	}
+
+	return nil
+}
+
+// luhnModN returns the check character of s computed with the Luhn mod N algorithm, where N is the
+// number of characters of the alphabet.
+func luhnModN(alphabet string, s []byte) (byte, error) {
+	n := len(alphabet)
+	factor := 2
+	sum := 0
+	// Start from the rightmost character, the one next to the check character
+	for i := len(s) - 1; i >= 0; i-- {
+		code := strings.IndexByte(alphabet, s[i])
+		if code == -1 {
+			return 0, fmt.Errorf("character %q is not part of the alphabet", s[i])
+		}
+
+		addend := factor * code
+		// Sum the digits of the addend expressed in base n
+		sum += addend/n + addend%n
+		factor = 3 - factor
+	}
+
+	return alphabet[(n-sum%n)%n], nil
+}
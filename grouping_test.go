@@ -0,0 +1,135 @@
+package atoll
+
+import (
+	"testing"
+)
+
+func TestGroupingFormat(t *testing.T) {
+	cases := []struct {
+		g        *Grouping
+		secret   string
+		expected string
+	}{
+		{g: &Grouping{}, secret: "k7Qp9xZm2wLr", expected: "k7Qp-9xZm-2wLr"},
+		{g: &Grouping{Size: 5, Delimiter: " "}, secret: "k7Qp9xZm2wLr", expected: "k7Qp9 xZm2w Lr"},
+		{g: &Grouping{Size: 3, Delimiter: "-", Alphabet: string(Digit), Check: true}, secret: "799273", expected: "7997-2733"},
+		{g: &Grouping{}, secret: "", expected: ""},
+	}
+
+	for _, tc := range cases {
+		got, err := tc.g.Format([]byte(tc.secret))
+		if err != nil {
+			t.Fatalf("Format() failed: %v", err)
+		}
+		if string(got) != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+
+		parsed, err := tc.g.Parse(got)
+		if err != nil {
+			t.Fatalf("Parse() failed: %v", err)
+		}
+		if string(parsed) != tc.secret {
+			t.Errorf("Expected %q, got %q", tc.secret, parsed)
+		}
+	}
+}
+
+func TestGroupingRoundTrip(t *testing.T) {
+	p := &Password{Length: 23, Levels: []Level{Lower, Upper, Digit, Special}, Repeat: true}
+	g := &Grouping{Size: 4, Check: true, Alphabet: string(Lower + Upper + Digit + Special)}
+
+	for i := 0; i < 50; i++ {
+		secret, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		// The delimiter may be part of the secret
+		formatted, err := g.Format(secret)
+		if err != nil {
+			t.Fatalf("Format() failed: %v", err)
+		}
+		parsed, err := g.Parse(formatted)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", formatted, err)
+		}
+		if string(parsed) != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, parsed)
+		}
+	}
+}
+
+func TestGroupingCheckDetectsErrors(t *testing.T) {
+	g := &Grouping{Size: 4, Check: true}
+	_, _, alphabet := g.params()
+
+	formatted, err := g.Format([]byte("k7Qp9xZm"))
+	if err != nil {
+		t.Fatalf("Format() failed: %v", err)
+	}
+
+	// Every single character substitution must be detected
+	for i := range formatted {
+		if formatted[i] == '-' {
+			continue
+		}
+		for j := 0; j < len(alphabet); j++ {
+			if alphabet[j] == formatted[i] {
+				continue
+			}
+			typo := []byte(string(formatted))
+			typo[i] = alphabet[j]
+			if _, err := g.Parse(typo); err == nil {
+				t.Errorf("Expected %q to be invalid", typo)
+			}
+		}
+	}
+}
+
+func TestLuhnModN(t *testing.T) {
+	cases := []struct {
+		alphabet string
+		s        string
+		expected byte
+	}{
+		{alphabet: string(Digit), s: "7992739871", expected: '3'},
+		{alphabet: "abcdef", s: "abcdef", expected: 'e'},
+	}
+
+	for _, tc := range cases {
+		got, err := luhnModN(tc.alphabet, []byte(tc.s))
+		if err != nil {
+			t.Fatalf("luhnModN() failed: %v", err)
+		}
+		if got != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestInvalidGrouping(t *testing.T) {
+	formatCases := map[string]*Grouping{
+		"short alphabet":    {Check: true, Alphabet: "a"},
+		"repeated alphabet": {Check: true, Alphabet: "abca"},
+		"not in alphabet":   {Check: true, Alphabet: "xyz"},
+	}
+	for name, g := range formatCases {
+		if _, err := g.Format([]byte("abc")); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	g := &Grouping{Check: true}
+	parseCases := map[string]string{
+		"missing delimiter":  "k7QpVk7QpV",
+		"trailing delimiter": "k7QpV-",
+		"short group":        "k7QpV-a",
+		"invalid check":      "k7Qpa",
+	}
+	for name, formatted := range parseCases {
+		if _, err := g.Parse([]byte(formatted)); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
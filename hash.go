@@ -0,0 +1,211 @@
+package atoll
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// phcEncoding is the base64 encoding used by the PHC string format.
+var phcEncoding = base64.RawStdEncoding
+
+const (
+	// OWASP recommendation for PBKDF2-HMAC-SHA256
+	pbkdf2DefaultIterations = 600000
+	// Default of PostgreSQL's scram_iterations setting
+	scramDefaultIterations = 4096
+)
+
+// HashPBKDF2 returns the PBKDF2-SHA256 hash of the password with a random salt in the PHC string
+// format: $pbkdf2-sha256$i=600000$<salt>$<hash>. If iterations is 0, 600000 are used.
+func HashPBKDF2(password []byte, iterations int) (string, error) {
+	if iterations == 0 {
+		iterations = pbkdf2DefaultIterations
+	}
+	if iterations < 1 {
+		return "", fmt.Errorf("atoll: iterations must be equal to or higher than 1, got %d", iterations)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	return hashPBKDF2(password, salt, iterations), nil
+}
+
+// VerifyPBKDF2 reports whether the password matches the PBKDF2-SHA256 hash in PHC string format.
+func VerifyPBKDF2(password []byte, encoded string) (bool, error) {
+	ok, err := verifyPBKDF2(password, encoded)
+	if err != nil {
+		return false, fmt.Errorf("atoll: %w", err)
+	}
+
+	return ok, nil
+}
+
+// HashSCRAMSHA256 returns the SCRAM-SHA-256 verifier of the password with a random salt in the format
+// stored by PostgreSQL, which can be used in CREATE ROLE ... PASSWORD '<verifier>':
+//
+//	SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
+//
+// If iterations is 0, 4096 are used. The password isn't normalized with SASLprep, which leaves ASCII
+// passwords unchanged.
+func HashSCRAMSHA256(password []byte, iterations int) (string, error) {
+	if iterations == 0 {
+		iterations = scramDefaultIterations
+	}
+	if iterations < 1 {
+		return "", fmt.Errorf("atoll: iterations must be equal to or higher than 1, got %d", iterations)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	storedKey, serverKey := scramKeys(password, salt, iterations)
+	return fmt.Sprintf("SCRAM-SHA-256$%d:%s$%s:%s", iterations, base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(storedKey), base64.StdEncoding.EncodeToString(serverKey)), nil
+}
+
+// VerifySCRAMSHA256 reports whether the password matches the PostgreSQL SCRAM-SHA-256 verifier.
+func VerifySCRAMSHA256(password []byte, verifier string) (bool, error) {
+	rest, ok := strings.CutPrefix(verifier, "SCRAM-SHA-256$")
+	params, keys, ok2 := strings.Cut(rest, "$")
+	iterationsStr, saltStr, ok3 := strings.Cut(params, ":")
+	storedStr, serverStr, ok4 := strings.Cut(keys, ":")
+	if !ok || !ok2 || !ok3 || !ok4 {
+		return false, errors.New("atoll: invalid SCRAM-SHA-256 verifier")
+	}
+
+	iterations, err := strconv.Atoi(iterationsStr)
+	if err != nil || iterations < 1 {
+		return false, fmt.Errorf("atoll: invalid iterations %q", iterationsStr)
+	}
+	salt, err := base64.StdEncoding.DecodeString(saltStr)
+	if err != nil {
+		return false, fmt.Errorf("atoll: invalid salt: %w", err)
+	}
+	expectedStored, err := base64.StdEncoding.DecodeString(storedStr)
+	if err != nil {
+		return false, fmt.Errorf("atoll: invalid stored key: %w", err)
+	}
+	expectedServer, err := base64.StdEncoding.DecodeString(serverStr)
+	if err != nil {
+		return false, fmt.Errorf("atoll: invalid server key: %w", err)
+	}
+
+	storedKey, serverKey := scramKeys(password, salt, iterations)
+	storedOK := subtle.ConstantTimeCompare(storedKey, expectedStored)
+	serverOK := subtle.ConstantTimeCompare(serverKey, expectedServer)
+	return storedOK&serverOK == 1, nil
+}
+
+// HashHtpasswdSHA returns the {SHA} hash of the password, to be written in htpasswd files as user:hash.
+//
+// The hash is unsalted SHA-1, use it only for servers that support nothing else.
+func HashHtpasswdSHA(password []byte) string {
+	sum := sha1.Sum(password)
+	return "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// VerifyHtpasswdSHA reports whether the password matches the htpasswd {SHA} hash.
+func VerifyHtpasswdSHA(password []byte, encoded string) (bool, error) {
+	if !strings.HasPrefix(encoded, "{SHA}") {
+		return false, errors.New("atoll: invalid {SHA} hash")
+	}
+
+	return subtle.ConstantTimeCompare([]byte(HashHtpasswdSHA(password)), []byte(encoded)) == 1, nil
+}
+
+// scramKeys returns the StoredKey and ServerKey of the password as defined in RFC 5802.
+func scramKeys(password, salt []byte, iterations int) (storedKey, serverKey []byte) {
+	salted := pbkdf2Key(sha256.New, password, salt, iterations, sha256.Size)
+
+	mac := hmac.New(sha256.New, salted)
+	mac.Write([]byte("Client Key"))
+	clientKey := sha256.Sum256(mac.Sum(nil))
+
+	mac = hmac.New(sha256.New, salted)
+	mac.Write([]byte("Server Key"))
+	return clientKey[:], mac.Sum(nil)
+}
+
+// pbkdf2Key derives a key of keyLen bytes from the password and the salt as specified in RFC 8018.
+func pbkdf2Key(h func() hash.Hash, password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	blocks := (keyLen + hashLen - 1) / hashLen
+
+	var counter [4]byte
+	key := make([]byte, 0, blocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= blocks; block++ {
+		// U1 = PRF(password, salt || INT(block))
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(counter[:], uint32(block))
+		prf.Write(counter[:])
+		key = prf.Sum(key)
+		t := key[len(key)-hashLen:]
+		copy(u, t)
+
+		// T = U1 ^ U2 ^ ... ^ Uc
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range u {
+				t[j] ^= u[j]
+			}
+		}
+	}
+
+	return key[:keyLen]
+}
+
+// hashPBKDF2 returns the PBKDF2-SHA256 hash of the password in the PHC string format:
+//
+//	$pbkdf2-sha256$i=<iterations>$<salt>$<hash>
+func hashPBKDF2(password, salt []byte, iterations int) string {
+	key := pbkdf2Key(sha256.New, password, salt, iterations, sha256.Size)
+	return fmt.Sprintf("$pbkdf2-sha256$i=%d$%s$%s", iterations, phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key))
+}
+
+// verifyPBKDF2 reports whether the password matches the PBKDF2-SHA256 hash in PHC string format, the
+// hashes are compared in constant time.
+func verifyPBKDF2(password []byte, encoded string) (bool, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) != 5 || fields[0] != "" {
+		return false, errors.New("invalid PHC string format")
+	}
+	if fields[1] != "pbkdf2-sha256" {
+		return false, fmt.Errorf("unsupported algorithm %q", fields[1])
+	}
+
+	iterations, err := strconv.Atoi(strings.TrimPrefix(fields[2], "i="))
+	if err != nil || !strings.HasPrefix(fields[2], "i=") || iterations < 1 {
+		return false, fmt.Errorf("invalid parameters %q", fields[2])
+	}
+	salt, err := phcEncoding.DecodeString(fields[3])
+	if err != nil {
+		return false, fmt.Errorf("invalid salt: %w", err)
+	}
+	expected, err := phcEncoding.DecodeString(fields[4])
+	if err != nil || len(expected) == 0 {
+		return false, errors.New("invalid hash")
+	}
+
+	key := pbkdf2Key(sha256.New, password, salt, iterations, len(expected))
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
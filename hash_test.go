@@ -0,0 +1,214 @@
+package atoll
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestPBKDF2Key(t *testing.T) {
+	// RFC 6070 and RFC 7914 vectors
+	cases := []struct {
+		sha1       bool
+		password   string
+		salt       string
+		iterations int
+		keyLen     int
+		expected   string
+	}{
+		{sha1: true, password: "password", salt: "salt", iterations: 1, keyLen: 20, expected: "0c60c80f961f0e71f3a9b524af6012062fe037a6"},
+		{sha1: true, password: "password", salt: "salt", iterations: 4096, keyLen: 20, expected: "4b007901b765489abead49d926f721d065a429c1"},
+		{
+			sha1: true, password: "passwordPASSWORDpassword", salt: "saltSALTsaltSALTsaltSALTsaltSALTsalt",
+			iterations: 4096, keyLen: 25, expected: "3d2eec4fe41c849b80c8d83662c0e44a8b291a964cf2f07038",
+		},
+		{password: "password", salt: "salt", iterations: 1, keyLen: 32, expected: "120fb6cffcf8b32c43e7225256c4f837a86548c92ccc35480805987cb70be17b"},
+		{password: "password", salt: "salt", iterations: 4096, keyLen: 32, expected: "c5e478d59288c841aa530db6845c4c8d962893a001ce4e11a4963873aa98134a"},
+		{
+			password: "passwd", salt: "salt", iterations: 1, keyLen: 64,
+			expected: "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+				"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783",
+		},
+	}
+
+	for _, tc := range cases {
+		h := sha256.New
+		if tc.sha1 {
+			h = sha1.New
+		}
+
+		got := pbkdf2Key(h, []byte(tc.password), []byte(tc.salt), tc.iterations, tc.keyLen)
+		if hex.EncodeToString(got) != tc.expected {
+			t.Errorf("Expected %s, got %x", tc.expected, got)
+		}
+	}
+}
+
+func TestPBKDF2PHC(t *testing.T) {
+	// Computed with Python's hashlib.pbkdf2_hmac
+	encoded := "$pbkdf2-sha256$i=1000$MDEyMzQ1Njc4OWFiY2RlZg$ff8DJwa4XzKdo2wRDysgI6ubjDYXanKhrrM/gyF5JZQ"
+	if got := hashPBKDF2([]byte("ABCDE12345"), []byte("0123456789abcdef"), 1000); got != encoded {
+		t.Errorf("Expected %q, got %q", encoded, got)
+	}
+
+	cases := []struct {
+		password string
+		expected bool
+	}{
+		{password: "ABCDE12345", expected: true},
+		{password: "ABCDE12346", expected: false},
+		{password: "", expected: false},
+	}
+
+	for _, tc := range cases {
+		got, err := verifyPBKDF2([]byte(tc.password), encoded)
+		if err != nil {
+			t.Fatalf("verifyPBKDF2() failed: %v", err)
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %t, got %t", tc.password, tc.expected, got)
+		}
+	}
+}
+
+func TestVerifyPBKDF2Errors(t *testing.T) {
+	cases := map[string]string{
+		"format":     "pbkdf2-sha256$i=1000$MDEy$ff8D",
+		"fields":     "$pbkdf2-sha256$i=1000$MDEy",
+		"algorithm":  "$pbkdf2-sha512$i=1000$MDEy$ff8D",
+		"parameters": "$pbkdf2-sha256$1000$MDEy$ff8D",
+		"iterations": "$pbkdf2-sha256$i=0$MDEy$ff8D",
+		"salt":       "$pbkdf2-sha256$i=1000$MDE=$ff8D",
+		"hash":       "$pbkdf2-sha256$i=1000$MDEy$",
+	}
+
+	for name, encoded := range cases {
+		if _, err := verifyPBKDF2([]byte("password"), encoded); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
+
+func TestHashPBKDF2(t *testing.T) {
+	password := []byte("s3cr3t-P4ss")
+	hash, err := HashPBKDF2(password, 1000)
+	if err != nil {
+		t.Fatalf("HashPBKDF2() failed: %v", err)
+	}
+	if !strings.HasPrefix(hash, "$pbkdf2-sha256$i=1000$") {
+		t.Errorf("Invalid hash %q", hash)
+	}
+
+	cases := []struct {
+		password string
+		expected bool
+	}{
+		{password: "s3cr3t-P4ss", expected: true},
+		{password: "s3cr3t-P4sS", expected: false},
+	}
+
+	for _, tc := range cases {
+		got, err := VerifyPBKDF2([]byte(tc.password), hash)
+		if err != nil {
+			t.Fatalf("VerifyPBKDF2() failed: %v", err)
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %t, got %t", tc.password, tc.expected, got)
+		}
+	}
+
+	if _, err := HashPBKDF2(password, -1); err == nil {
+		t.Error("Expected iterations error, got nil")
+	}
+	if _, err := VerifyPBKDF2(password, "invalid"); err == nil {
+		t.Error("Expected format error, got nil")
+	}
+}
+
+func TestSCRAMSHA256(t *testing.T) {
+	// Computed with Python's hashlib and hmac following RFC 5802
+	verifier := "SCRAM-SHA-256$4096:MDEyMzQ1Njc4OWFiY2RlZg==$tSgetjYW5SQlNzIxJAKMryuDGH8Snw5oWcj1eOW0ZyI=:" +
+		"bbZ1dJMgYYaKKR1fU1oGHkWJzi9OQY4yU8Fuksa1t70="
+
+	cases := []struct {
+		password string
+		expected bool
+	}{
+		{password: "correct horse", expected: true},
+		{password: "correct horse ", expected: false},
+	}
+
+	for _, tc := range cases {
+		got, err := VerifySCRAMSHA256([]byte(tc.password), verifier)
+		if err != nil {
+			t.Fatalf("VerifySCRAMSHA256() failed: %v", err)
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %t, got %t", tc.password, tc.expected, got)
+		}
+	}
+
+	generated, err := HashSCRAMSHA256([]byte("correct horse"), 0)
+	if err != nil {
+		t.Fatalf("HashSCRAMSHA256() failed: %v", err)
+	}
+	if !strings.HasPrefix(generated, "SCRAM-SHA-256$4096:") {
+		t.Errorf("Invalid verifier %q", generated)
+	}
+	if ok, err := VerifySCRAMSHA256([]byte("correct horse"), generated); err != nil || !ok {
+		t.Errorf("Expected %q to match: %v", generated, err)
+	}
+
+	if _, err := HashSCRAMSHA256([]byte("correct horse"), -5); err == nil {
+		t.Error("Expected iterations error, got nil")
+	}
+}
+
+func TestVerifySCRAMSHA256Errors(t *testing.T) {
+	cases := map[string]string{
+		"prefix":     "SCRAM-SHA-1$4096:MDEy$MDEy:MDEy",
+		"format":     "SCRAM-SHA-256$4096:MDEy",
+		"iterations": "SCRAM-SHA-256$0:MDEy$MDEy:MDEy",
+		"salt":       "SCRAM-SHA-256$4096:M$MDEy:MDEy",
+		"stored key": "SCRAM-SHA-256$4096:MDEy$M:MDEy",
+		"server key": "SCRAM-SHA-256$4096:MDEy$MDEy:M",
+	}
+
+	for name, verifier := range cases {
+		if _, err := VerifySCRAMSHA256([]byte("password"), verifier); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
+
+func TestHtpasswdSHA(t *testing.T) {
+	// Computed with openssl dgst -sha1
+	expected := "{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="
+	if got := HashHtpasswdSHA([]byte("password")); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	cases := []struct {
+		password string
+		expected bool
+	}{
+		{password: "password", expected: true},
+		{password: "Password", expected: false},
+	}
+
+	for _, tc := range cases {
+		got, err := VerifyHtpasswdSHA([]byte(tc.password), expected)
+		if err != nil {
+			t.Fatalf("VerifyHtpasswdSHA() failed: %v", err)
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %t, got %t", tc.password, tc.expected, got)
+		}
+	}
+
+	if _, err := VerifyHtpasswdSHA([]byte("password"), "W6ph5Mm5Pz8GgiULbPgzG37mj9g="); err == nil {
+		t.Error("Expected prefix error, got nil")
+	}
+}
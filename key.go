@@ -0,0 +1,177 @@
+package atoll
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// KeyAlgorithm represents the algorithm a symmetric key is used with, using the JSON Web
+// Algorithms (RFC 7518) names.
+type KeyAlgorithm uint8
+
+// Key algorithms.
+const (
+	// HS256 is HMAC with SHA-256, used to sign JWTs.
+	HS256 KeyAlgorithm = iota
+	// HS384 is HMAC with SHA-384, used to sign JWTs.
+	HS384
+	// HS512 is HMAC with SHA-512, used to sign JWTs.
+	HS512
+	// A128GCM is AES-128 in Galois/Counter Mode.
+	A128GCM
+	// A192GCM is AES-192 in Galois/Counter Mode.
+	A192GCM
+	// A256GCM is AES-256 in Galois/Counter Mode.
+	A256GCM
+)
+
+var keyAlgorithms = [...]struct {
+	name string
+	size int
+}{
+	// HMAC keys have the size of the hash output as required by RFC 7518
+	HS256:   {name: "HS256", size: 32},
+	HS384:   {name: "HS384", size: 48},
+	HS512:   {name: "HS512", size: 64},
+	A128GCM: {name: "A128GCM", size: 16},
+	A192GCM: {name: "A192GCM", size: 24},
+	A256GCM: {name: "A256GCM", size: 32},
+}
+
+// String returns the name of the algorithm.
+func (a KeyAlgorithm) String() string {
+	if int(a) >= len(keyAlgorithms) {
+		return "KeyAlgorithm(" + strconv.Itoa(int(a)) + ")"
+	}
+	return keyAlgorithms[a].name
+}
+
+// Size returns the number of bytes of the keys of the algorithm.
+func (a KeyAlgorithm) Size() int {
+	if int(a) >= len(keyAlgorithms) {
+		return 0
+	}
+	return keyAlgorithms[a].size
+}
+
+// Key represents a symmetric key, like the ones used to sign JWTs or to encrypt data.
+//
+// Generate creates random key material of the size required by the algorithm, which can then be
+// exported in base64 or as a JSON Web Key (RFC 7517).
+//
+// There is no PEM export: PEM wraps DER structures like PKCS #8, which describe asymmetric keys
+// (see Ed25519Key and X25519Key), and no widely supported PEM type exists for symmetric keys. Tools that
+// read them from files expect the raw bytes or base64.
+type Key struct {
+	// Algorithm the key is used with, HS256 by default.
+	Algorithm KeyAlgorithm
+	// Key material, set by Generate. It can be set to export an existing key.
+	Raw []byte
+}
+
+// Generate generates random key material, stores it in Raw and returns it.
+func (k *Key) Generate() ([]byte, error) {
+	key, err := k.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return key, nil
+}
+
+func (k *Key) generate() ([]byte, error) {
+	if k.Algorithm.Size() == 0 {
+		return nil, fmt.Errorf("invalid algorithm: %d", k.Algorithm)
+	}
+
+	key := make([]byte, k.Algorithm.Size())
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	k.Raw = key
+
+	return key, nil
+}
+
+// Entropy returns the key entropy in bits.
+func (k *Key) Entropy() float64 {
+	if k.Raw != nil {
+		return float64(len(k.Raw) * 8)
+	}
+	return float64(k.Algorithm.Size() * 8)
+}
+
+// Base64 returns the key encoded in standard base64 with padding.
+func (k *Key) Base64() (string, error) {
+	if err := k.validate(); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(k.Raw), nil
+}
+
+// JWK returns the key as a JSON Web Key (RFC 7517) whose "kid" is its thumbprint:
+//
+//	{"kty":"oct","kid":"...","alg":"HS256","use":"sig","k":"..."}
+func (k *Key) JWK() ([]byte, error) {
+	thumbprint, err := k.Thumbprint()
+	if err != nil {
+		return nil, err
+	}
+
+	use := "enc"
+	if k.Algorithm <= HS512 {
+		use = "sig"
+	}
+
+	jwk := struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Alg string `json:"alg"`
+		Use string `json:"use"`
+		K   string `json:"k"`
+	}{
+		Kty: "oct",
+		Kid: thumbprint,
+		Alg: k.Algorithm.String(),
+		Use: use,
+		K:   base64.RawURLEncoding.EncodeToString(k.Raw),
+	}
+	return json.Marshal(jwk)
+}
+
+// Thumbprint returns the JWK thumbprint (RFC 7638) of the key: the base64url encoded SHA-256 hash
+// of its required members in lexicographic order.
+func (k *Key) Thumbprint() (string, error) {
+	if err := k.validate(); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	// base64url doesn't need JSON escaping
+	members := `{"k":"` + base64.RawURLEncoding.EncodeToString(k.Raw) + `","kty":"oct"}`
+	sum := sha256.Sum256([]byte(members))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func (k *Key) validate() error {
+	size := k.Algorithm.Size()
+	if size == 0 {
+		return fmt.Errorf("invalid algorithm: %d", k.Algorithm)
+	}
+
+	if len(k.Raw) == 0 {
+		return errors.New("the key is empty, call Generate or set it")
+	}
+
+	// HMAC accepts longer keys but AES keys have a fixed size
+	if len(k.Raw) < size || (k.Algorithm > HS512 && len(k.Raw) != size) {
+		return fmt.Errorf("%s requires a key of %d bytes, got %d", k.Algorithm, size, len(k.Raw))
+	}
+
+	return nil
+}
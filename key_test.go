@@ -0,0 +1,130 @@
+package atoll
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	cases := []struct {
+		algorithm KeyAlgorithm
+		size      int
+		use       string
+	}{
+		{algorithm: HS256, size: 32, use: "sig"},
+		{algorithm: HS384, size: 48, use: "sig"},
+		{algorithm: HS512, size: 64, use: "sig"},
+		{algorithm: A128GCM, size: 16, use: "enc"},
+		{algorithm: A192GCM, size: 24, use: "enc"},
+		{algorithm: A256GCM, size: 32, use: "enc"},
+	}
+
+	for _, tc := range cases {
+		k := &Key{Algorithm: tc.algorithm}
+		raw, err := k.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		if len(raw) != tc.size {
+			t.Errorf("%s: expected %d bytes, got %d", tc.algorithm, tc.size, len(raw))
+		}
+		if got := k.Entropy(); got != float64(tc.size*8) {
+			t.Errorf("Expected %d, got %f", tc.size*8, got)
+		}
+
+		encoded, err := k.Base64()
+		if err != nil {
+			t.Fatalf("Base64() failed: %v", err)
+		}
+		if decoded, _ := base64.StdEncoding.DecodeString(encoded); string(decoded) != string(raw) {
+			t.Errorf("Expected %q to decode to the key", encoded)
+		}
+
+		data, err := k.JWK()
+		if err != nil {
+			t.Fatalf("JWK() failed: %v", err)
+		}
+		var jwk map[string]string
+		if err := json.Unmarshal(data, &jwk); err != nil {
+			t.Fatalf("Invalid JWK %s: %v", data, err)
+		}
+		thumbprint, _ := k.Thumbprint()
+		if jwk["kty"] != "oct" || jwk["alg"] != tc.algorithm.String() || jwk["use"] != tc.use || jwk["kid"] != thumbprint {
+			t.Errorf("Invalid JWK %s", data)
+		}
+		if decoded, _ := base64.RawURLEncoding.DecodeString(jwk["k"]); string(decoded) != string(raw) {
+			t.Errorf("Expected %q to decode to the key", jwk["k"])
+		}
+	}
+}
+
+func TestKeyThumbprint(t *testing.T) {
+	// Symmetric key of RFC 7520 section 3.5, the thumbprint was computed with Python
+	raw, _ := base64.RawURLEncoding.DecodeString("hJtXIZ2uSN5kbQfbtTNWbpdmhkV8FJG-Onbc6mxCcYg")
+	k := &Key{Algorithm: HS256, Raw: raw}
+
+	thumbprint, err := k.Thumbprint()
+	if err != nil {
+		t.Fatalf("Thumbprint() failed: %v", err)
+	}
+	expected := "RtoRur_1Dir5M4wuOfqNkDYOf9O_4RJ-aHkTA75RLA8"
+	if thumbprint != expected {
+		t.Errorf("Expected %q, got %q", expected, thumbprint)
+	}
+
+	jwk, err := k.JWK()
+	if err != nil {
+		t.Fatalf("JWK() failed: %v", err)
+	}
+	expectedJWK := `{"kty":"oct","kid":"RtoRur_1Dir5M4wuOfqNkDYOf9O_4RJ-aHkTA75RLA8","alg":"HS256","use":"sig",` +
+		`"k":"hJtXIZ2uSN5kbQfbtTNWbpdmhkV8FJG-Onbc6mxCcYg"}`
+	if string(jwk) != expectedJWK {
+		t.Errorf("Expected %s, got %s", expectedJWK, jwk)
+	}
+
+	b64, err := k.Base64()
+	if err != nil {
+		t.Fatalf("Base64() failed: %v", err)
+	}
+	if expected := "hJtXIZ2uSN5kbQfbtTNWbpdmhkV8FJG+Onbc6mxCcYg="; b64 != expected {
+		t.Errorf("Expected %q, got %q", expected, b64)
+	}
+}
+
+func TestInvalidKey(t *testing.T) {
+	if _, err := (&Key{Algorithm: 6}).Generate(); err == nil {
+		t.Error("Expected invalid algorithm error, got nil")
+	}
+
+	cases := map[string]*Key{
+		"invalid algorithm": {Algorithm: 10, Raw: make([]byte, 32)},
+		"empty key":         {},
+		"short HMAC key":    {Algorithm: HS512, Raw: make([]byte, 32)},
+		"long AES key":      {Algorithm: A128GCM, Raw: make([]byte, 32)},
+	}
+
+	for name, k := range cases {
+		if _, err := k.Base64(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+		if _, err := k.JWK(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	// HMAC keys may be longer than the hash output
+	if _, err := (&Key{Algorithm: HS256, Raw: make([]byte, 64)}).JWK(); err != nil {
+		t.Errorf("JWK() failed: %v", err)
+	}
+}
+
+func TestKeyAlgorithmString(t *testing.T) {
+	if got := A256GCM.String(); got != "A256GCM" {
+		t.Errorf("Expected %q, got %q", "A256GCM", got)
+	}
+	if got := KeyAlgorithm(9).String(); got != "KeyAlgorithm(9)" {
+		t.Errorf("Expected %q, got %q", "KeyAlgorithm(9)", got)
+	}
+}
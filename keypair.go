@@ -0,0 +1,211 @@
+package atoll
+
+import (
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Ed25519Key represents an Ed25519 signing keypair.
+//
+// Generate creates a random keypair and returns the private key in PKCS #8 PEM format. The public
+// key can be exported separately in PKIX PEM format or as an OpenSSH authorized_keys line.
+type Ed25519Key struct {
+	// Private key, set by Generate. It can be set to export an existing key.
+	Private ed25519.PrivateKey
+	// Comment appended to the authorized_keys line, like "alice@example.com".
+	Comment string
+}
+
+// Generate generates a random keypair, stores it in Private and returns the private key in
+// PKCS #8 PEM format.
+func (k *Ed25519Key) Generate() ([]byte, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+	k.Private = private
+
+	return k.PrivatePEM()
+}
+
+// Entropy returns the key entropy in bits, the size of the seed the keypair is derived from.
+func (k *Ed25519Key) Entropy() float64 {
+	return ed25519.SeedSize * 8
+}
+
+// PublicKey returns the raw public key.
+func (k *Ed25519Key) PublicKey() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return []byte(k.Private.Public().(ed25519.PublicKey)), nil
+}
+
+// PrivatePEM returns the private key in PKCS #8 PEM format.
+func (k *Ed25519Key) PrivatePEM() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return privatePEM(k.Private)
+}
+
+// PublicPEM returns the public key in PKIX PEM format.
+func (k *Ed25519Key) PublicPEM() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return publicPEM(k.Private.Public())
+}
+
+// AuthorizedKey returns the public key as an OpenSSH authorized_keys line:
+//
+//	ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAA... alice@example.com
+func (k *Ed25519Key) AuthorizedKey() ([]byte, error) {
+	public, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(k.Comment, "\r\n") {
+		return nil, errors.New("atoll: comment cannot contain line breaks")
+	}
+
+	const keyType = "ssh-ed25519"
+	// The key blob is made of strings prefixed by their length, as defined in RFC 4253
+	blob := make([]byte, 0, 4+len(keyType)+4+len(public))
+	blob = binary.BigEndian.AppendUint32(blob, uint32(len(keyType)))
+	blob = append(blob, keyType...)
+	blob = binary.BigEndian.AppendUint32(blob, uint32(len(public)))
+	blob = append(blob, public...)
+
+	line := keyType + " " + base64.StdEncoding.EncodeToString(blob)
+	if k.Comment != "" {
+		line += " " + k.Comment
+	}
+	return []byte(line), nil
+}
+
+func (k *Ed25519Key) validate() error {
+	if len(k.Private) != ed25519.PrivateKeySize {
+		return fmt.Errorf("private key must be %d bytes long, got %d", ed25519.PrivateKeySize, len(k.Private))
+	}
+	return nil
+}
+
+// X25519Key represents an X25519 key agreement keypair, the kind used by WireGuard.
+//
+// Generate creates a random keypair and returns the private key encoded in base64 like `wg genkey`
+// does. The public key can be exported separately in base64, like `wg pubkey`, or in PKIX PEM format.
+type X25519Key struct {
+	// Private key, set by Generate. It can be set to export an existing key.
+	Private *ecdh.PrivateKey
+}
+
+// Generate generates a random keypair, stores it in Private and returns the private key encoded
+// in base64.
+func (k *X25519Key) Generate() ([]byte, error) {
+	private, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+	k.Private = private
+
+	return k.Base64()
+}
+
+// Entropy returns the key entropy in bits. X25519 clamps the 32 random bytes of the private key,
+// setting or clearing 5 of its bits.
+func (k *X25519Key) Entropy() float64 {
+	return 251
+}
+
+// PublicKey returns the raw public key.
+func (k *X25519Key) PublicKey() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return k.Private.PublicKey().Bytes(), nil
+}
+
+// Base64 returns the private key encoded in base64, the format used in WireGuard configurations.
+func (k *X25519Key) Base64() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return []byte(base64.StdEncoding.EncodeToString(k.Private.Bytes())), nil
+}
+
+// PublicBase64 returns the public key encoded in base64, the format used in WireGuard configurations.
+func (k *X25519Key) PublicBase64() ([]byte, error) {
+	public, err := k.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.StdEncoding.EncodeToString(public)), nil
+}
+
+// PrivatePEM returns the private key in PKCS #8 PEM format.
+func (k *X25519Key) PrivatePEM() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return privatePEM(k.Private)
+}
+
+// PublicPEM returns the public key in PKIX PEM format.
+func (k *X25519Key) PublicPEM() ([]byte, error) {
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return publicPEM(k.Private.PublicKey())
+}
+
+func (k *X25519Key) validate() error {
+	if k.Private == nil {
+		return errors.New("the private key is empty, call Generate or set it")
+	}
+	if k.Private.Curve() != ecdh.X25519() {
+		return errors.New("private key is not an X25519 key")
+	}
+	return nil
+}
+
+// privatePEM returns the private key in PKCS #8 PEM format.
+func privatePEM(private any) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	encoded := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	// Wipe sensitive data
+	for i := range der {
+		der[i] = 0
+	}
+	return encoded, nil
+}
+
+// publicPEM returns the public key in PKIX PEM format.
+func publicPEM(public any) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
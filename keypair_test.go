@@ -0,0 +1,179 @@
+package atoll
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"testing"
+)
+
+func TestEd25519Key(t *testing.T) {
+	k := &Ed25519Key{}
+	privatePEM, err := k.Generate()
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	block, _ := pem.Decode(privatePEM)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		t.Fatalf("Invalid private key PEM: %s", privatePEM)
+	}
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed parsing private key: %v", err)
+	}
+	if !k.Private.Equal(private) {
+		t.Error("Expected PEM to hold the generated key")
+	}
+
+	publicPEM, err := k.PublicPEM()
+	if err != nil {
+		t.Fatalf("PublicPEM() failed: %v", err)
+	}
+	block, _ = pem.Decode(publicPEM)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		t.Fatalf("Invalid public key PEM: %s", publicPEM)
+	}
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed parsing public key: %v", err)
+	}
+	raw, err := k.PublicKey()
+	if err != nil {
+		t.Fatalf("PublicKey() failed: %v", err)
+	}
+	if !bytes.Equal(public.(ed25519.PublicKey), raw) {
+		t.Error("Expected PEM to hold the public key")
+	}
+
+	if got := k.Entropy(); got != 256 {
+		t.Errorf("Expected 256, got %f", got)
+	}
+}
+
+func TestEd25519AuthorizedKey(t *testing.T) {
+	// RFC 8032 section 7.1, test 1
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	k := &Ed25519Key{Private: ed25519.NewKeyFromSeed(seed)}
+
+	cases := []struct {
+		comment  string
+		expected string
+	}{
+		{expected: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINdamAGCsQq31Uv+08lkBzoO4XLz2qYjJa8CGmj3B1Ea"},
+		{
+			comment:  "alice@example.com",
+			expected: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINdamAGCsQq31Uv+08lkBzoO4XLz2qYjJa8CGmj3B1Ea alice@example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		k.Comment = tc.comment
+		got, err := k.AuthorizedKey()
+		if err != nil {
+			t.Fatalf("AuthorizedKey() failed: %v", err)
+		}
+		if string(got) != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+
+	k.Comment = "alice\nssh-ed25519 AAAA"
+	if _, err := k.AuthorizedKey(); err == nil {
+		t.Error("Expected comment error, got nil")
+	}
+}
+
+func TestX25519Key(t *testing.T) {
+	k := &X25519Key{}
+	encoded, err := k.Generate()
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	if len(encoded) != 44 {
+		t.Errorf("Expected 44 characters, got %q", encoded)
+	}
+
+	privatePEM, err := k.PrivatePEM()
+	if err != nil {
+		t.Fatalf("PrivatePEM() failed: %v", err)
+	}
+	block, _ := pem.Decode(privatePEM)
+	if block == nil {
+		t.Fatalf("Invalid private key PEM: %s", privatePEM)
+	}
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed parsing private key: %v", err)
+	}
+	if !k.Private.Equal(private) {
+		t.Error("Expected PEM to hold the generated key")
+	}
+
+	publicPEM, err := k.PublicPEM()
+	if err != nil {
+		t.Fatalf("PublicPEM() failed: %v", err)
+	}
+	block, _ = pem.Decode(publicPEM)
+	if block == nil {
+		t.Fatalf("Invalid public key PEM: %s", publicPEM)
+	}
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed parsing public key: %v", err)
+	}
+	if !k.Private.PublicKey().Equal(public) {
+		t.Error("Expected PEM to hold the public key")
+	}
+}
+
+func TestX25519KeyWireGuard(t *testing.T) {
+	// RFC 7748 section 6.1, Alice's keypair
+	raw, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	private, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &X25519Key{Private: private}
+
+	got, err := k.Base64()
+	if err != nil {
+		t.Fatalf("Base64() failed: %v", err)
+	}
+	if expected := "dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo="; string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	got, err = k.PublicBase64()
+	if err != nil {
+		t.Fatalf("PublicBase64() failed: %v", err)
+	}
+	if expected := "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo="; string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestInvalidKeypair(t *testing.T) {
+	ed := &Ed25519Key{Private: make([]byte, 10)}
+	if _, err := ed.PrivatePEM(); err == nil {
+		t.Error("Expected invalid key error, got nil")
+	}
+	if _, err := ed.AuthorizedKey(); err == nil {
+		t.Error("Expected invalid key error, got nil")
+	}
+
+	if _, err := (&X25519Key{}).PublicBase64(); err == nil {
+		t.Error("Expected empty key error, got nil")
+	}
+	p256, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&X25519Key{Private: p256}).Base64(); err == nil {
+		t.Error("Expected curve error, got nil")
+	}
+}
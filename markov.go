@@ -0,0 +1,420 @@
+package atoll
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Corpus identifies a word list embedded in the package that Markov sources can be trained on.
+type Corpus uint8
+
+// Embedded corpora.
+const (
+	// EnglishCorpus is the word list used by WordList.
+	EnglishCorpus Corpus = iota
+	// SpanishCorpus is a list of common Spanish words written without accents.
+	SpanishCorpus
+)
+
+const (
+	// markovStart pads the beginning of the words so the first letters have a state.
+	markovStart = '^'
+	// markovEnd is the transition letter that finishes a word.
+	markovEnd = 0
+	// minAcceptance is the minimum probability of a walk producing a valid word.
+	minAcceptance = 0.01
+)
+
+// Chains trained on the embedded corpora, they are trained on first use and shared afterwards.
+var markovChains sync.Map
+
+// Markov is a word source that generates invented words using a Markov chain of letters trained
+// on a corpus, so they resemble the words of its language.
+//
+// Each letter is chosen with the probability it followed the previous ones (as many as the order
+// of the chain) in the corpus. Words out of the length limits and words that are part of the corpus
+// are discarded, and the entropy reported is the exact entropy of the words that remain.
+type Markov struct {
+	chain *markovChain
+	// Minimum and maximum number of letters of each word, 4 and 10 by default.
+	MinLength uint64
+	MaxLength uint64
+	// Allow generating words that are part of the corpus, they are discarded by default.
+	AllowCorpusWords bool
+	// Statistics of the words accepted and the parameters used to compute them.
+	stats    *markovStats
+	statsKey [3]int
+}
+
+// markovChain holds the transitions between the states of a chain, a state is made of the last
+// letters generated.
+type markovChain struct {
+	order int
+	start int
+	// transitions[s] are the letters that can follow the state s.
+	transitions [][]markovTransition
+	// totals[s] is the number of times the state s was observed.
+	totals []int
+	corpus map[string]struct{}
+}
+
+type markovTransition struct {
+	letter byte
+	// Index of the state reached, unused when letter is markovEnd.
+	next  int
+	count int
+}
+
+// markovStats holds the sums over the accepted words of their probability, their probability
+// times their information content and their probability times their length, and the number of
+// accepted words.
+type markovStats struct {
+	prob      float64
+	surprisal float64
+	letters   float64
+	words     float64
+}
+
+// NewMarkov returns a Markov source of the given order (2 to 4) trained on an embedded corpus.
+func NewMarkov(corpus Corpus, order int) (*Markov, error) {
+	if err := validateOrder(order); err != nil {
+		return nil, err
+	}
+
+	var words [][]byte
+	switch corpus {
+	case EnglishCorpus:
+		words = wordList
+	case SpanishCorpus:
+		words = spanishWordList
+	default:
+		return nil, fmt.Errorf("atoll: unknown corpus %d", corpus)
+	}
+
+	key := [2]int{int(corpus), order}
+	chain, ok := markovChains.Load(key)
+	if !ok {
+		chain, _ = markovChains.LoadOrStore(key, trainChain(words, order))
+	}
+
+	return &Markov{chain: chain.(*markovChain)}, nil
+}
+
+// TrainMarkov returns a Markov source of the given order (2 to 4) trained on the words read
+// from r, separated by white space.
+//
+// Words are lowercased and the ones containing characters other than ASCII letters are skipped.
+func TrainMarkov(r io.Reader, order int) (*Markov, error) {
+	if err := validateOrder(order); err != nil {
+		return nil, err
+	}
+
+	var words [][]byte
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		word := strings.ToLower(scanner.Text())
+		if strings.Trim(word, string(Lower)) == "" {
+			words = append(words, []byte(word))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("atoll: reading corpus: %w", err)
+	}
+
+	if len(words) == 0 {
+		return nil, errors.New("atoll: the corpus has no valid words")
+	}
+
+	return &Markov{chain: trainChain(words, order)}, nil
+}
+
+// Word returns a random word, or nil if the source wasn't created with NewMarkov or TrainMarkov or
+// its parameters are invalid, as rejecting words that rarely meet them could take forever.
+func (m *Markov) Word(int) []byte {
+	if m.validate() != nil {
+		return nil
+	}
+
+	min, max := m.lengths()
+	for {
+		word, ok := m.chain.walk(max)
+		if !ok || len(word) < min {
+			continue
+		}
+		if _, ok := m.chain.corpus[string(word)]; ok && !m.AllowCorpusWords {
+			continue
+		}
+		return word
+	}
+}
+
+// Entropy returns the entropy in bits of a word.
+func (m *Markov) Entropy(int) float64 {
+	if m.chain == nil {
+		return 0
+	}
+
+	// The probability of each word is the one of its walk conditioned on being accepted
+	st := m.markovStats()
+	return st.surprisal/st.prob + math.Log2(st.prob)
+}
+
+// meanLetters returns the expected number of letters of a word.
+func (m *Markov) meanLetters(int) float64 {
+	if m.chain == nil {
+		return 0
+	}
+
+	st := m.markovStats()
+	return st.letters / st.prob
+}
+
+// wordCount returns the number of different words generated.
+func (m *Markov) wordCount() float64 {
+	if m.chain == nil {
+		return 0
+	}
+	return m.markovStats().words
+}
+
+// validate checks that the source was trained and that it produces enough words within the limits.
+func (m *Markov) validate() error {
+	if m.chain == nil {
+		return errors.New("markov source must be created with NewMarkov or TrainMarkov")
+	}
+
+	if m.MaxLength != 0 && m.MinLength > m.MaxLength {
+		return errors.New("minimum length is higher than the maximum length")
+	}
+
+	if m.markovStats().prob < minAcceptance {
+		min, max := m.lengths()
+		return fmt.Errorf("the markov chain rarely generates new words of %d to %d letters", min, max)
+	}
+
+	return nil
+}
+
+// lengths returns the length limits of the words.
+func (m *Markov) lengths() (min, max int) {
+	min, max = 4, 10
+	if m.MinLength != 0 {
+		min = int(m.MinLength)
+	}
+	if m.MaxLength != 0 {
+		max = int(m.MaxLength)
+	}
+
+	if min > max {
+		// Only the minimum was set
+		max = min
+	}
+
+	return min, max
+}
+
+// markovStats returns the statistics of the words accepted, the result is cached as long as the
+// parameters used to compute them don't change.
+func (m *Markov) markovStats() markovStats {
+	min, max := m.lengths()
+	allow := 0
+	if m.AllowCorpusWords {
+		allow = 1
+	}
+
+	key := [3]int{min, max, allow}
+	if m.stats == nil || m.statsKey != key {
+		st := m.chain.stats(min, max, m.AllowCorpusWords)
+		m.stats, m.statsKey = &st, key
+	}
+
+	return *m.stats
+}
+
+// trainChain returns a chain of the given order with the transitions observed in words.
+func trainChain(words [][]byte, order int) *markovChain {
+	counts := make(map[string]map[byte]int)
+	corpus := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		corpus[string(word)] = struct{}{}
+
+		padded := strings.Repeat(string(markovStart), order) + string(word)
+		for i := 0; i <= len(word); i++ {
+			state := padded[i : i+order]
+			if counts[state] == nil {
+				counts[state] = make(map[byte]int)
+			}
+			letter := byte(markovEnd)
+			if i < len(word) {
+				letter = word[i]
+			}
+			counts[state][letter]++
+		}
+	}
+
+	states := make([]string, 0, len(counts))
+	for state := range counts {
+		states = append(states, state)
+	}
+	slices.Sort(states)
+	index := make(map[string]int, len(states))
+	for i, state := range states {
+		index[state] = i
+	}
+
+	c := &markovChain{
+		order:       order,
+		start:       index[strings.Repeat(string(markovStart), order)],
+		transitions: make([][]markovTransition, len(states)),
+		totals:      make([]int, len(states)),
+		corpus:      corpus,
+	}
+	for i, state := range states {
+		letters := make([]byte, 0, len(counts[state]))
+		for letter := range counts[state] {
+			letters = append(letters, letter)
+		}
+		slices.Sort(letters)
+
+		for _, letter := range letters {
+			t := markovTransition{letter: letter, count: counts[state][letter]}
+			if letter != markovEnd {
+				// Every state reached during the training has transitions
+				t.next = index[state[1:]+string(letter)]
+			}
+			c.transitions[i] = append(c.transitions[i], t)
+			c.totals[i] += t.count
+		}
+	}
+
+	return c
+}
+
+// walk generates a word following the chain, it fails if the word exceeds max letters.
+func (c *markovChain) walk(max int) ([]byte, bool) {
+	var word []byte
+	state := c.start
+	for {
+		t := c.pick(state)
+		if t.letter == markovEnd {
+			return word, true
+		}
+		if len(word) == max {
+			return nil, false
+		}
+		word = append(word, t.letter)
+		state = t.next
+	}
+}
+
+// pick returns a random transition of the state weighted by the times it was observed.
+func (c *markovChain) pick(state int) markovTransition {
+	r := int(randInt(c.totals[state]))
+	for _, t := range c.transitions[state] {
+		if r < t.count {
+			return t
+		}
+		r -= t.count
+	}
+	panic("unreachable")
+}
+
+// stats returns the statistics of the words of min to max letters generated by the chain.
+func (c *markovChain) stats(min, max int, allowCorpus bool) markovStats {
+	var st markovStats
+
+	// prob[s] is the probability of the walks that reached the state s with the current length,
+	// surprisal[s] the sum of their probabilities times their information content and words[s]
+	// their number, each walk spells a different word
+	prob := make([]float64, len(c.transitions))
+	surprisal := make([]float64, len(c.transitions))
+	words := make([]float64, len(c.transitions))
+	prob[c.start] = 1
+	words[c.start] = 1
+
+	for length := 0; length <= max; length++ {
+		nextProb := make([]float64, len(c.transitions))
+		nextSurprisal := make([]float64, len(c.transitions))
+		nextWords := make([]float64, len(c.transitions))
+		for s, p := range prob {
+			if p == 0 {
+				continue
+			}
+			for _, t := range c.transitions[s] {
+				tp := float64(t.count) / float64(c.totals[s])
+				mass := p * tp
+				info := surprisal[s]*tp - mass*math.Log2(tp)
+
+				if t.letter == markovEnd {
+					if length >= min {
+						st.prob += mass
+						st.surprisal += info
+						st.letters += mass * float64(length)
+						st.words += words[s]
+					}
+					continue
+				}
+				if length < max {
+					nextProb[t.next] += mass
+					nextSurprisal[t.next] += info
+					nextWords[t.next] += words[s]
+				}
+			}
+		}
+		prob, surprisal, words = nextProb, nextSurprisal, nextWords
+	}
+
+	if !allowCorpus {
+		for word := range c.corpus {
+			if len(word) < min || len(word) > max {
+				continue
+			}
+			p := c.prob(word)
+			if p == 0 {
+				continue
+			}
+			st.prob -= p
+			st.words--
+			st.surprisal += p * math.Log2(p)
+			st.letters -= p * float64(len(word))
+		}
+	}
+
+	return st
+}
+
+// prob returns the probability of the chain generating word.
+func (c *markovChain) prob(word string) float64 {
+	p := 1.0
+	state := c.start
+	for i := 0; i <= len(word); i++ {
+		letter := byte(markovEnd)
+		if i < len(word) {
+			letter = word[i]
+		}
+
+		idx := slices.IndexFunc(c.transitions[state], func(t markovTransition) bool { return t.letter == letter })
+		if idx == -1 {
+			return 0
+		}
+		t := c.transitions[state][idx]
+		p *= float64(t.count) / float64(c.totals[state])
+		state = t.next
+	}
+	return p
+}
+
+// validateOrder checks that the order of a chain is supported.
+func validateOrder(order int) error {
+	if order < 2 || order > 4 {
+		return fmt.Errorf("atoll: markov chain order must be between 2 and 4, got %d", order)
+	}
+	return nil
+}
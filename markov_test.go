@@ -0,0 +1,167 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestMarkov(t *testing.T) {
+	for _, corpus := range []Corpus{EnglishCorpus, SpanishCorpus} {
+		for order := 2; order <= 4; order++ {
+			m, err := NewMarkov(corpus, order)
+			if err != nil {
+				t.Fatalf("NewMarkov() failed: %v", err)
+			}
+			m.MinLength = 5
+			m.MaxLength = 8
+
+			if err := m.validate(); err != nil {
+				t.Fatalf("Corpus %d, order %d: %v", corpus, order, err)
+			}
+
+			for i := 0; i < 100; i++ {
+				word := m.Word(i)
+				if len(word) < 5 || len(word) > 8 {
+					t.Errorf("Expected a word of 5 to 8 letters, got %q", word)
+				}
+				if _, ok := m.chain.corpus[string(word)]; ok {
+					t.Errorf("Corpus word %q was generated", word)
+				}
+			}
+		}
+	}
+}
+
+func TestInvalidMarkovWord(t *testing.T) {
+	m, err := NewMarkov(EnglishCorpus, 3)
+	if err != nil {
+		t.Fatalf("NewMarkov() failed: %v", err)
+	}
+	m.MinLength = 40
+
+	cases := map[string]*Markov{
+		"untrained":   {},
+		"rarely meet": m,
+	}
+	for k, tc := range cases {
+		if word := tc.Word(0); word != nil {
+			t.Errorf("%s: expected nil, got %q", k, word)
+		}
+	}
+}
+
+func TestMarkovEntropy(t *testing.T) {
+	m, err := TrainMarkov(strings.NewReader("abab abba baba aab Bab a-b"), 2)
+	if err != nil {
+		t.Fatalf("TrainMarkov() failed: %v", err)
+	}
+
+	if _, ok := m.chain.corpus["bab"]; !ok {
+		t.Error("Expected words to be lowercased")
+	}
+	if _, ok := m.chain.corpus["a-b"]; ok {
+		t.Error("Expected words with non-letter characters to be skipped")
+	}
+
+	cases := []struct {
+		min, max uint64
+		allow    bool
+	}{
+		{min: 2, max: 6, allow: false},
+		{min: 2, max: 6, allow: true},
+		{min: 4, max: 5, allow: false},
+	}
+	for _, tc := range cases {
+		m.MinLength, m.MaxLength, m.AllowCorpusWords = tc.min, tc.max, tc.allow
+
+		// Enumerate every accepted word with its probability
+		words := make(map[string]float64)
+		var walk func(state int, word string, p float64)
+		walk = func(state int, word string, p float64) {
+			for _, tr := range m.chain.transitions[state] {
+				q := p * float64(tr.count) / float64(m.chain.totals[state])
+				if tr.letter == markovEnd {
+					_, inCorpus := m.chain.corpus[word]
+					if len(word) >= int(tc.min) && (tc.allow || !inCorpus) {
+						words[word] += q
+					}
+					continue
+				}
+				if len(word) < int(tc.max) {
+					walk(tr.next, word+string(tr.letter), q)
+				}
+			}
+		}
+		walk(m.chain.start, "", 1)
+
+		var total float64
+		for _, p := range words {
+			total += p
+		}
+		var expected, letters float64
+		for w, p := range words {
+			expected -= p / total * math.Log2(p/total)
+			letters += p / total * float64(len(w))
+		}
+
+		if got := m.Entropy(0); math.Abs(got-expected) > 1e-9 {
+			t.Errorf("%+v: expected %f, got %f", tc, expected, got)
+		}
+		if got := m.meanLetters(0); math.Abs(got-letters) > 1e-9 {
+			t.Errorf("%+v: expected %f mean letters, got %f", tc, letters, got)
+		}
+	}
+}
+
+func TestMarkovPassphrase(t *testing.T) {
+	m, err := NewMarkov(SpanishCorpus, 3)
+	if err != nil {
+		t.Fatalf("NewMarkov() failed: %v", err)
+	}
+
+	p := &Passphrase{Length: 5, Separator: "-", Source: m}
+	passphrase, err := p.Generate()
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	if words := bytes.Split(passphrase, []byte("-")); len(words) != 5 {
+		t.Errorf("Expected 5 words, got %q", passphrase)
+	}
+
+	expected := 5 * m.Entropy(0)
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestInvalidMarkov(t *testing.T) {
+	if _, err := NewMarkov(EnglishCorpus, 1); err == nil {
+		t.Error("Expected invalid order error, got nil")
+	}
+	if _, err := NewMarkov(Corpus(9), 2); err == nil {
+		t.Error("Expected unknown corpus error, got nil")
+	}
+	if _, err := TrainMarkov(strings.NewReader("123 a-b"), 2); err == nil {
+		t.Error("Expected no valid words error, got nil")
+	}
+
+	m, err := NewMarkov(SpanishCorpus, 4)
+	if err != nil {
+		t.Fatalf("NewMarkov() failed: %v", err)
+	}
+
+	cases := map[string]*Markov{
+		"untrained":       {},
+		"invalid lengths": {chain: m.chain, MinLength: 6, MaxLength: 5},
+		"rare words":      {chain: m.chain, MinLength: 14, MaxLength: 16},
+	}
+	for name, src := range cases {
+		p := &Passphrase{Length: 3, Source: src}
+		if _, err := p.Generate(); err == nil {
+			t.Errorf("%s: expected an error, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package atoll
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// fitModel counts the sequences of items (words and separators) whose total length fits
+// in a number of characters, so they can be sampled uniformly without rejections.
+type fitModel struct {
+	// Candidates of each item grouped by their length.
+	items []*lengthClasses
+	// counts[i][b] is the number of sequences of items[i:] that fit in b characters.
+	counts [][]*big.Int
+	// letters[i][b] is the sum of the letters of the words of those sequences.
+	letters [][]*big.Int
+	// Whether each item is a word (and its letters are counted).
+	isWord []bool
+	budget int
+}
+
+// lengthClasses holds a set of candidates indexed by their length.
+type lengthClasses struct {
+	words [][][]byte
+	// Sum of the letters of the candidates of each length.
+	letters []int64
+}
+
+// newLengthClasses groups the candidates by their length.
+func newLengthClasses(candidates [][]byte) *lengthClasses {
+	classes := &lengthClasses{}
+	for _, c := range candidates {
+		for len(classes.words) <= len(c) {
+			classes.words = append(classes.words, nil)
+			classes.letters = append(classes.letters, 0)
+		}
+		classes.words[len(c)] = append(classes.words[len(c)], c)
+		classes.letters[len(c)] += int64(letterCount(c))
+	}
+	return classes
+}
+
+// newFitModel returns a model of the sequences of words followed by separators that fit in budget
+// characters.
+func newFitModel(words []*lengthClasses, seps []*lengthClasses, budget int) *fitModel {
+	items := append(slices.Clone(words), seps...)
+
+	// Every sequence fits in the sum of the longest candidate of each item, larger budgets are
+	// equivalent and would only make the tables bigger
+	longest := 0
+	for _, item := range items {
+		longest += max(len(item.words)-1, 0)
+	}
+	budget = min(budget, longest)
+
+	m := &fitModel{
+		items:  items,
+		budget: budget,
+	}
+	m.isWord = make([]bool, len(m.items))
+	for i := range words {
+		m.isWord[i] = true
+	}
+
+	n := len(m.items)
+	m.counts = make([][]*big.Int, n+1)
+	m.letters = make([][]*big.Int, n+1)
+	for i := range m.counts {
+		m.counts[i] = make([]*big.Int, budget+1)
+		m.letters[i] = make([]*big.Int, budget+1)
+	}
+
+	// An empty sequence always fits
+	for b := 0; b <= budget; b++ {
+		m.counts[n][b] = big.NewInt(1)
+		m.letters[n][b] = big.NewInt(0)
+	}
+
+	tmp := new(big.Int)
+	for i := n - 1; i >= 0; i-- {
+		for b := 0; b <= budget; b++ {
+			count, letters := new(big.Int), new(big.Int)
+			for length, class := range m.items[i].words {
+				if len(class) == 0 || length > b {
+					continue
+				}
+				size := big.NewInt(int64(len(class)))
+				rest := b - length
+
+				count.Add(count, tmp.Mul(size, m.counts[i+1][rest]))
+				letters.Add(letters, tmp.Mul(size, m.letters[i+1][rest]))
+				if m.isWord[i] {
+					sum := big.NewInt(m.items[i].letters[length])
+					letters.Add(letters, tmp.Mul(sum, m.counts[i+1][rest]))
+				}
+			}
+			m.counts[i][b] = count
+			m.letters[i][b] = letters
+		}
+	}
+
+	return m
+}
+
+// count returns the number of sequences that fit in the budget.
+func (m *fitModel) count() *big.Int {
+	return m.counts[0][m.budget]
+}
+
+// meanLetters returns the expected number of letters of the words of a sequence.
+func (m *fitModel) meanLetters() float64 {
+	if m.count().Sign() == 0 {
+		return 0
+	}
+	mean, _ := new(big.Rat).SetFrac(m.letters[0][m.budget], m.count()).Float64()
+	return mean
+}
+
+// sample returns a sequence chosen uniformly from all the ones that fit in the budget.
+func (m *fitModel) sample() ([][]byte, error) {
+	total := m.count()
+	if total.Sign() == 0 {
+		return nil, errors.New("no sequence fits in the budget")
+	}
+
+	r, err := rand.Int(rand.Reader, total)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the r-th sequence, candidates are ordered by length and position in their class
+	seq := make([][]byte, len(m.items))
+	b := m.budget
+	tmp, idx := new(big.Int), new(big.Int)
+	for i, classes := range m.items {
+		for length, class := range classes.words {
+			if len(class) == 0 || length > b {
+				continue
+			}
+			rest := m.counts[i+1][b-length]
+			tmp.Mul(big.NewInt(int64(len(class))), rest)
+			if r.Cmp(tmp) >= 0 {
+				r.Sub(r, tmp)
+				continue
+			}
+
+			idx.DivMod(r, rest, r)
+			seq[i] = class[idx.Int64()]
+			b -= length
+			break
+		}
+	}
+
+	return seq, nil
+}
+
+// log2Big returns the base 2 logarithm of x.
+func log2Big(x *big.Int) float64 {
+	if x.Sign() <= 0 {
+		return math.Inf(-1)
+	}
+
+	// Keep the 62 most significant bits, enough for a float64 mantissa
+	shift := 0
+	if x.BitLen() > 62 {
+		shift = x.BitLen() - 62
+	}
+	top := new(big.Int).Rsh(x, uint(shift))
+	return math.Log2(float64(top.Uint64())) + float64(shift)
+}
+
+// fitModel returns the model of the words and separators that can be generated for a passphrase of
+// the given length so it doesn't exceed MaxChars.
+func (p *Passphrase) fitModel(length uint64) (*fitModel, error) {
+	gaps := int(length) - 1
+	// The budget is clamped to the longest passphrase later, avoid overflows before that
+	budget := int(min(p.MaxChars, math.MaxInt32))
+	for _, incl := range p.Include {
+		budget -= len(incl)
+	}
+	for _, pad := range p.Padding {
+		budget -= int(pad.Count)
+		if pad.Position == PadBetween {
+			gaps++
+		}
+	}
+
+	var seps []*lengthClasses
+	if len(p.Separators) == 0 {
+		sep := p.Separator
+		if sep == "" {
+			sep = " "
+		}
+		budget -= gaps * len(sep)
+	} else {
+		sepBytes := make([][]byte, len(p.Separators))
+		for i, sep := range p.Separators {
+			sepBytes[i] = []byte(sep)
+		}
+		classes := newLengthClasses(sepBytes)
+		for i := 0; i < gaps; i++ {
+			seps = append(seps, classes)
+		}
+	}
+
+	if budget < 0 {
+		return nil, fmt.Errorf("a passphrase of %d words does not fit in %d characters", length, p.MaxChars)
+	}
+
+	words := make([]*lengthClasses, int(length)-len(p.Include))
+	for i := range words {
+		words[i] = p.lengthClasses(i)
+	}
+
+	m := newFitModel(words, seps, budget)
+	if m.count().Sign() == 0 {
+		return nil, fmt.Errorf("a passphrase of %d words does not fit in %d characters", length, p.MaxChars)
+	}
+
+	return m, nil
+}
+
+// lengthClasses returns the words of the list used for the i-th word grouped by their length, the
+// result is cached as long as the parameters used to build it don't change.
+func (p *Passphrase) lengthClasses(i int) *lengthClasses {
+	key := fmt.Sprint(p.MinWordLength, p.MaxWordLength, p.Exclude)
+	if p.classesKey != key {
+		p.classes = make(map[string]*lengthClasses)
+		p.classesKey = key
+	}
+
+	name := p.slotName(i)
+	if classes, ok := p.classes[name]; ok {
+		return classes
+	}
+
+	// Excluded words are taken out from the list beforehand so they don't need to be replaced
+	source := slices.DeleteFunc(slices.Clone(p.slotSource(i)), func(word []byte) bool {
+		return slices.ContainsFunc(p.Exclude, equalFold(word))
+	})
+
+	p.classes[name] = newLengthClasses(source)
+	return p.classes[name]
+}
+
+// validateMaxChars checks that the passphrase fits in MaxChars for every possible length.
+func (p *Passphrase) validateMaxChars() error {
+	if p.MaxChars == 0 {
+		return nil
+	}
+
+	if len(p.Template) == 0 && p.listSource() == nil {
+		return errors.New("maximum characters requires a word or syllable list")
+	}
+
+	if p.Unique {
+		return errors.New("maximum characters cannot be combined with unique words")
+	}
+
+	if len(p.Template) != 0 {
+		_, err := p.fitModel(uint64(len(p.Template)))
+		return err
+	}
+
+	if p.MaxLength == 0 {
+		_, err := p.fitModel(p.Length)
+		return err
+	}
+
+	for i, prob := range lengthProbabilities(p.MinLength, p.MaxLength, p.LengthWeights) {
+		if prob == 0 {
+			continue
+		}
+		if _, err := p.fitModel(p.MinLength + uint64(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fitWords generates the words (and separators) of a passphrase of the given length so it doesn't
+// exceed MaxChars. Every passphrase that fits is equally likely.
+func (p *Passphrase) fitWords(length uint64) error {
+	m, err := p.fitModel(length)
+	if err != nil {
+		return err
+	}
+
+	seq, err := m.sample()
+	if err != nil {
+		return err
+	}
+
+	n := int(length) - len(p.Include)
+	copy(p.words, seq[:n])
+	if len(p.Separators) != 0 {
+		p.seps = seq[n:]
+	}
+
+	return nil
+}
+
+// fitEntropy returns the entropy of a passphrase of the given length that doesn't exceed MaxChars.
+func (p *Passphrase) fitEntropy(length uint64) float64 {
+	m, err := p.fitModel(length)
+	if err != nil {
+		return 0
+	}
+
+	// Generated words are uniformly distributed, included ones are placed in random positions
+	entropy := log2Big(m.count())
+	for i := 0; i < len(p.Include); i++ {
+		entropy += math.Log2(float64(int(length) - i))
+	}
+
+	switch p.Case {
+	case RandomWordCase:
+		entropy += float64(length)
+	case RandomLetterCase:
+		entropy += m.meanLetters()
+		for _, incl := range p.Include {
+			entropy += float64(letterCount([]byte(incl)))
+		}
+	}
+
+	// Separators are part of the model already
+	return entropy + p.paddingEntropy(length)
+}
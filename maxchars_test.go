@@ -0,0 +1,180 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestFitModel(t *testing.T) {
+	classes := newLengthClasses([][]byte{[]byte("a"), []byte("bb"), []byte("ccc")})
+	m := newFitModel([]*lengthClasses{classes, classes}, nil, 4)
+
+	if m.count().Cmp(big.NewInt(6)) != 0 {
+		t.Fatalf("Expected 6 sequences, got %s", m.count())
+	}
+
+	// (1+1)+(1+2)+(1+3)+(2+1)+(2+2)+(3+1) = 20
+	if got, expected := m.meanLetters(), 20.0/6; math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f mean letters, got %f", expected, got)
+	}
+
+	counts := make(map[string]int)
+	n := 6000
+	for i := 0; i < n; i++ {
+		seq, err := m.sample()
+		if err != nil {
+			t.Fatalf("sample() failed: %v", err)
+		}
+		counts[string(bytes.Join(seq, []byte(" ")))]++
+	}
+
+	if len(counts) != 6 {
+		t.Errorf("Expected 6 different sequences, got %d: %v", len(counts), counts)
+	}
+	for seq, c := range counts {
+		if len(strings.ReplaceAll(seq, " ", "")) > 4 {
+			t.Errorf("Sequence %q does not fit", seq)
+		}
+		// The expected count is 1000 and its standard deviation ~29
+		if c < 850 || c > 1150 {
+			t.Errorf("Sequence %q is biased: %d/%d", seq, c, n)
+		}
+	}
+}
+
+func TestFitModelBudget(t *testing.T) {
+	classes := newLengthClasses([][]byte{[]byte("a"), []byte("bb"), []byte("ccc")})
+
+	// Budgets higher than the longest sequence are equivalent to it
+	m := newFitModel([]*lengthClasses{classes, classes}, nil, 1<<34)
+	if m.budget != 6 {
+		t.Errorf("Expected the budget to be clamped to 6, got %d", m.budget)
+	}
+	if m.count().Cmp(big.NewInt(9)) != 0 {
+		t.Errorf("Expected 9 sequences, got %s", m.count())
+	}
+
+	p := &Passphrase{Length: 5, List: WordList, MaxChars: 1 << 34}
+	if _, err := p.Generate(); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	expected := 5 * math.Log2(float64(len(wordList)))
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestLog2Big(t *testing.T) {
+	cases := []*big.Int{
+		big.NewInt(1),
+		big.NewInt(1000),
+		new(big.Int).Exp(big.NewInt(18325), big.NewInt(7), nil),
+		new(big.Int).Exp(big.NewInt(3), big.NewInt(200), nil),
+	}
+	expected := []float64{0, math.Log2(1000), 7 * math.Log2(18325), 200 * math.Log2(3)}
+
+	for i, tc := range cases {
+		if got := log2Big(tc); math.Abs(got-expected[i]) > 1e-9 {
+			t.Errorf("Expected %f, got %f", expected[i], got)
+		}
+	}
+}
+
+func TestPassphraseMaxChars(t *testing.T) {
+	cases := map[string]*Passphrase{
+		"Word list": {
+			Length:    7,
+			Separator: "-",
+			List:      WordList,
+			MaxChars:  32,
+		},
+		"Random separators and padding": {
+			Length:     5,
+			Separators: []string{"-", "__", "..."},
+			List:       WordList,
+			Padding: []Padding{
+				{Level: Digit, Count: 2, Position: PadBetween},
+				{Level: Special, Count: 1, Position: PadAfter},
+			},
+			Include:  []string{"atoll"},
+			Exclude:  []string{"apple"},
+			Case:     TitleCase,
+			MaxChars: 40,
+		},
+		"Length range": {
+			MinLength: 3,
+			MaxLength: 6,
+			List:      SyllableList,
+			MaxChars:  20,
+		},
+	}
+
+	for k, tc := range cases {
+		t.Run(k, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				passphrase, err := tc.Generate()
+				if err != nil {
+					t.Fatalf("Generate() failed: %v", err)
+				}
+
+				if len(passphrase) > int(tc.MaxChars) {
+					t.Errorf("Passphrase %q exceeds %d characters", passphrase, tc.MaxChars)
+				}
+			}
+		})
+	}
+
+	invalid := map[string]*Passphrase{
+		"no list":      {Length: 3, MaxChars: 30},
+		"unique":       {Length: 3, List: WordList, Unique: true, MaxChars: 30},
+		"too short":    {Length: 5, List: WordList, MaxChars: 8},
+		"long include": {Length: 2, List: WordList, Include: []string{"abcdefghij"}, MaxChars: 12},
+	}
+	for k, tc := range invalid {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
+
+func TestPassphraseMaxCharsEntropy(t *testing.T) {
+	var two, three float64
+	for _, s := range syllableList {
+		switch len(s) {
+		case 2:
+			two++
+		case 3:
+			three++
+		}
+	}
+
+	p := &Passphrase{
+		Length:    2,
+		Separator: "-",
+		List:      SyllableList,
+		MaxChars:  6,
+	}
+
+	// Only pairs of syllables of length 2+2, 2+3 and 3+2 fit
+	expected := math.Log2(two*two + 2*two*three)
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	// Every passphrase fits, three words are generated and the included one is placed
+	// in one of the four positions
+	p = &Passphrase{
+		Length:   4,
+		List:     WordList,
+		Include:  []string{"atoll"},
+		Case:     RandomWordCase,
+		MaxChars: 200,
+	}
+	expected = 3*math.Log2(float64(len(wordList))) + math.Log2(4) + 4
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
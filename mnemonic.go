@@ -0,0 +1,118 @@
+package atoll
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// mnemonicIndex maps each word of the mnemonic list and its first 4 letters to its index.
+var mnemonicIndex = sync.OnceValue(func() map[string]int {
+	index := make(map[string]int, 2*len(mnemonicList))
+	for i, word := range mnemonicList {
+		index[string(word)] = i
+		index[string(word[:4])] = i
+	}
+	return index
+})
+
+// EncodeMnemonic returns the words that encode the entropy along with a checksum, as in BIP39.
+//
+// The entropy must be 16 to 32 bytes long and a multiple of 4 bytes, each word encodes 11 bits
+// and the checksum is made of the first (bits / 32) bits of the SHA-256 hash of the entropy.
+//
+// The word list is not the one of BIP39, the mnemonics are not compatible with cryptocurrency wallets.
+func EncodeMnemonic(entropy []byte) ([]string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return nil, fmt.Errorf("atoll: entropy must be 16 to 32 bytes long and a multiple of 4, got %d bytes", len(entropy))
+	}
+
+	hash := sha256.Sum256(entropy)
+	// The checksum is at most 8 bits long
+	data := append(entropy[:len(entropy):len(entropy)], hash[0])
+
+	words := make([]string, (len(entropy)*8+len(entropy)/4)/11)
+	for i := range words {
+		idx := 0
+		for b := i * 11; b < (i+1)*11; b++ {
+			idx = idx<<1 | int(data[b/8]>>(7-b%8)&1)
+		}
+		words[i] = string(mnemonicList[idx])
+	}
+
+	return words, nil
+}
+
+// DecodeMnemonic returns the entropy encoded by the words and validates their checksum.
+//
+// Words are case-insensitive and can be abbreviated to their first 4 letters. If a word is not in
+// the list, the error suggests the closest one.
+func DecodeMnemonic(words []string) ([]byte, error) {
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("atoll: invalid number of words: %d", len(words))
+	}
+
+	index := mnemonicIndex()
+	bits := len(words) * 11
+	data := make([]byte, (bits+7)/8)
+	for i, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		idx, ok := index[word]
+		if !ok {
+			return nil, fmt.Errorf("atoll: unknown word %q in position %d, did you mean %q?", word, i+1, closestWord(word, mnemonicList))
+		}
+
+		for b := 0; b < 11; b++ {
+			if idx>>(10-b)&1 == 1 {
+				bit := i*11 + b
+				data[bit/8] |= 1 << (7 - bit%8)
+			}
+		}
+	}
+
+	entropyBits := bits * 32 / 33
+	entropy := data[:entropyBits/8]
+	checksumBits := entropyBits / 32
+
+	hash := sha256.Sum256(entropy)
+	if data[len(entropy)]>>(8-checksumBits) != hash[0]>>(8-checksumBits) {
+		return nil, errors.New("atoll: invalid mnemonic checksum")
+	}
+
+	return entropy, nil
+}
+
+// GenerateMnemonic returns the mnemonic of a random key of the given number of bits, which must be
+// 128, 160, 192, 224 or 256.
+func GenerateMnemonic(bits int) ([]string, error) {
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return nil, fmt.Errorf("atoll: bits must be 128, 160, 192, 224 or 256, got %d", bits)
+	}
+
+	entropy := make([]byte, bits/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	words, err := EncodeMnemonic(entropy)
+	// Wipe sensitive data
+	for i := range entropy {
+		entropy[i] = 0
+	}
+
+	return words, err
+}
+
+// MnemonicList generates a passphrase using the mnemonic word list (2048 long), where each word adds
+// 11 bits of entropy and can be identified by its first 4 letters.
+func MnemonicList(p *Passphrase, length int) {
+	source := p.filterWords(mnemonicList)
+	for i := 0; i < length; i++ {
+		p.words[i] = p.randWord(source, i)
+	}
+}
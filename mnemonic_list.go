@@ -0,0 +1,2054 @@
+package atoll
+
+// mnemonicList holds 2048 words of the word list with 4 to 8 letters whose first 4 letters
+// identify them, used to encode keys as mnemonics.
+var mnemonicList = [][]byte{
+	[]byte("aardvark"),
+	[]byte("abalone"),
+	[]byte("abbacy"),
+	[]byte("abdomen"),
+	[]byte("abel"),
+	[]byte("abhor"),
+	[]byte("abjure"),
+	[]byte("ablution"),
+	[]byte("aboard"),
+	[]byte("abort"),
+	[]byte("abrade"),
+	[]byte("abrupt"),
+	[]byte("absorb"),
+	[]byte("abuse"),
+	[]byte("academe"),
+	[]byte("acclaim"),
+	[]byte("acerbic"),
+	[]byte("acid"),
+	[]byte("aconite"),
+	[]byte("acquit"),
+	[]byte("acrylic"),
+	[]byte("actress"),
+	[]byte("acumen"),
+	[]byte("adam"),
+	[]byte("addle"),
+	[]byte("aden"),
+	[]byte("adieu"),
+	[]byte("adjoin"),
+	[]byte("admonish"),
+	[]byte("adore"),
+	[]byte("adsorb"),
+	[]byte("advice"),
+	[]byte("aeolian"),
+	[]byte("aero"),
+	[]byte("affair"),
+	[]byte("afford"),
+	[]byte("afire"),
+	[]byte("afoot"),
+	[]byte("afraid"),
+	[]byte("after"),
+	[]byte("agave"),
+	[]byte("ageless"),
+	[]byte("aghast"),
+	[]byte("aglitter"),
+	[]byte("agone"),
+	[]byte("agrimony"),
+	[]byte("ahead"),
+	[]byte("aide"),
+	[]byte("aileron"),
+	[]byte("airbag"),
+	[]byte("airlift"),
+	[]byte("airtight"),
+	[]byte("ajar"),
+	[]byte("alacrity"),
+	[]byte("albany"),
+	[]byte("alcalde"),
+	[]byte("alec"),
+	[]byte("alembic"),
+	[]byte("alfalfa"),
+	[]byte("algiers"),
+	[]byte("alice"),
+	[]byte("aliment"),
+	[]byte("allah"),
+	[]byte("allspice"),
+	[]byte("almighty"),
+	[]byte("aloft"),
+	[]byte("aloud"),
+	[]byte("alpine"),
+	[]byte("also"),
+	[]byte("altitude"),
+	[]byte("alva"),
+	[]byte("amah"),
+	[]byte("amass"),
+	[]byte("ambient"),
+	[]byte("ameba"),
+	[]byte("amethyst"),
+	[]byte("amid"),
+	[]byte("amity"),
+	[]byte("amnesia"),
+	[]byte("amoral"),
+	[]byte("amphora"),
+	[]byte("amulet"),
+	[]byte("anaemia"),
+	[]byte("anarch"),
+	[]byte("ancient"),
+	[]byte("androgen"),
+	[]byte("anemia"),
+	[]byte("angina"),
+	[]byte("angst"),
+	[]byte("anion"),
+	[]byte("ankle"),
+	[]byte("annul"),
+	[]byte("anon"),
+	[]byte("antacid"),
+	[]byte("antler"),
+	[]byte("anvil"),
+	[]byte("anymore"),
+	[]byte("anyway"),
+	[]byte("apart"),
+	[]byte("apex"),
+	[]byte("apiary"),
+	[]byte("apogee"),
+	[]byte("apostle"),
+	[]byte("apple"),
+	[]byte("apron"),
+	[]byte("aqua"),
+	[]byte("arachnid"),
+	[]byte("arbutus"),
+	[]byte("ardent"),
+	[]byte("arena"),
+	[]byte("argyle"),
+	[]byte("aries"),
+	[]byte("arkansan"),
+	[]byte("armful"),
+	[]byte("armor"),
+	[]byte("army"),
+	[]byte("around"),
+	[]byte("arrive"),
+	[]byte("artery"),
+	[]byte("arts"),
+	[]byte("aryan"),
+	[]byte("ascot"),
+	[]byte("ashamed"),
+	[]byte("ashtray"),
+	[]byte("aside"),
+	[]byte("asks"),
+	[]byte("aspen"),
+	[]byte("asset"),
+	[]byte("assyria"),
+	[]byte("astir"),
+	[]byte("asunder"),
+	[]byte("athens"),
+	[]byte("atilt"),
+	[]byte("atom"),
+	[]byte("atrophy"),
+	[]byte("attorney"),
+	[]byte("auburn"),
+	[]byte("auger"),
+	[]byte("auld"),
+	[]byte("auric"),
+	[]byte("author"),
+	[]byte("auxin"),
+	[]byte("avatar"),
+	[]byte("aver"),
+	[]byte("avis"),
+	[]byte("avouch"),
+	[]byte("award"),
+	[]byte("aweigh"),
+	[]byte("awkward"),
+	[]byte("axes"),
+	[]byte("axle"),
+	[]byte("azariah"),
+	[]byte("aztec"),
+	[]byte("baboon"),
+	[]byte("bach"),
+	[]byte("bacteria"),
+	[]byte("badly"),
+	[]byte("bagful"),
+	[]byte("bagpipe"),
+	[]byte("bail"),
+	[]byte("bake"),
+	[]byte("balcony"),
+	[]byte("bali"),
+	[]byte("balsa"),
+	[]byte("banal"),
+	[]byte("banish"),
+	[]byte("banquet"),
+	[]byte("baobab"),
+	[]byte("bare"),
+	[]byte("bark"),
+	[]byte("barque"),
+	[]byte("baruch"),
+	[]byte("basic"),
+	[]byte("bast"),
+	[]byte("batik"),
+	[]byte("batt"),
+	[]byte("bavaria"),
+	[]byte("baylor"),
+	[]byte("beach"),
+	[]byte("beam"),
+	[]byte("beat"),
+	[]byte("bebop"),
+	[]byte("become"),
+	[]byte("bedew"),
+	[]byte("bedouin"),
+	[]byte("bedtime"),
+	[]byte("beehive"),
+	[]byte("beer"),
+	[]byte("befell"),
+	[]byte("befuddle"),
+	[]byte("beggar"),
+	[]byte("begum"),
+	[]byte("behold"),
+	[]byte("beirut"),
+	[]byte("belfry"),
+	[]byte("below"),
+	[]byte("bemire"),
+	[]byte("bend"),
+	[]byte("bent"),
+	[]byte("berate"),
+	[]byte("berg"),
+	[]byte("bermuda"),
+	[]byte("bert"),
+	[]byte("beside"),
+	[]byte("bess"),
+	[]byte("beth"),
+	[]byte("better"),
+	[]byte("bewail"),
+	[]byte("bezoar"),
+	[]byte("bhutan"),
+	[]byte("bible"),
+	[]byte("bicuspid"),
+	[]byte("bien"),
+	[]byte("bigger"),
+	[]byte("bike"),
+	[]byte("bilge"),
+	[]byte("binary"),
+	[]byte("binomial"),
+	[]byte("biopsy"),
+	[]byte("biplane"),
+	[]byte("biretta"),
+	[]byte("bishop"),
+	[]byte("bistro"),
+	[]byte("bits"),
+	[]byte("bivouac"),
+	[]byte("blade"),
+	[]byte("blare"),
+	[]byte("bleak"),
+	[]byte("blemish"),
+	[]byte("blight"),
+	[]byte("bliss"),
+	[]byte("blob"),
+	[]byte("blood"),
+	[]byte("blow"),
+	[]byte("blue"),
+	[]byte("blunt"),
+	[]byte("boast"),
+	[]byte("bobolink"),
+	[]byte("boca"),
+	[]byte("bodice"),
+	[]byte("boggle"),
+	[]byte("bohemia"),
+	[]byte("bold"),
+	[]byte("bolo"),
+	[]byte("bomb"),
+	[]byte("bone"),
+	[]byte("bonito"),
+	[]byte("bonus"),
+	[]byte("boodle"),
+	[]byte("boom"),
+	[]byte("boot"),
+	[]byte("bore"),
+	[]byte("boron"),
+	[]byte("bosh"),
+	[]byte("boston"),
+	[]byte("botfly"),
+	[]byte("botulism"),
+	[]byte("bough"),
+	[]byte("bouquet"),
+	[]byte("bowdoin"),
+	[]byte("bowman"),
+	[]byte("boxful"),
+	[]byte("boyd"),
+	[]byte("brae"),
+	[]byte("brake"),
+	[]byte("brat"),
+	[]byte("bray"),
+	[]byte("bred"),
+	[]byte("brew"),
+	[]byte("bride"),
+	[]byte("brine"),
+	[]byte("broad"),
+	[]byte("broke"),
+	[]byte("broth"),
+	[]byte("brunt"),
+	[]byte("bubble"),
+	[]byte("budd"),
+	[]byte("bugbear"),
+	[]byte("build"),
+	[]byte("bull"),
+	[]byte("bump"),
+	[]byte("bunion"),
+	[]byte("buoy"),
+	[]byte("burg"),
+	[]byte("burn"),
+	[]byte("bursa"),
+	[]byte("busby"),
+	[]byte("buskin"),
+	[]byte("butane"),
+	[]byte("buxom"),
+	[]byte("bygone"),
+	[]byte("byplay"),
+	[]byte("byword"),
+	[]byte("cable"),
+	[]byte("cacao"),
+	[]byte("cacti"),
+	[]byte("cadge"),
+	[]byte("cady"),
+	[]byte("caffeine"),
+	[]byte("cahoot"),
+	[]byte("caisson"),
+	[]byte("cake"),
+	[]byte("calends"),
+	[]byte("calk"),
+	[]byte("calomel"),
+	[]byte("camber"),
+	[]byte("camp"),
+	[]byte("cancan"),
+	[]byte("canine"),
+	[]byte("canst"),
+	[]byte("capable"),
+	[]byte("capo"),
+	[]byte("carat"),
+	[]byte("card"),
+	[]byte("carhop"),
+	[]byte("carnal"),
+	[]byte("cart"),
+	[]byte("cascade"),
+	[]byte("cask"),
+	[]byte("cast"),
+	[]byte("catch"),
+	[]byte("cathode"),
+	[]byte("catnap"),
+	[]byte("catwalk"),
+	[]byte("caulk"),
+	[]byte("cave"),
+	[]byte("cayenne"),
+	[]byte("cedar"),
+	[]byte("celery"),
+	[]byte("celt"),
+	[]byte("cense"),
+	[]byte("cereal"),
+	[]byte("certain"),
+	[]byte("cesium"),
+	[]byte("chad"),
+	[]byte("chalk"),
+	[]byte("chap"),
+	[]byte("chaunt"),
+	[]byte("cheddar"),
+	[]byte("chemise"),
+	[]byte("chess"),
+	[]byte("chiao"),
+	[]byte("chief"),
+	[]byte("chime"),
+	[]byte("chisel"),
+	[]byte("chock"),
+	[]byte("chomp"),
+	[]byte("chord"),
+	[]byte("chrism"),
+	[]byte("chuff"),
+	[]byte("chunk"),
+	[]byte("cicada"),
+	[]byte("cilia"),
+	[]byte("cinnabar"),
+	[]byte("cistern"),
+	[]byte("citric"),
+	[]byte("clack"),
+	[]byte("clan"),
+	[]byte("clash"),
+	[]byte("claw"),
+	[]byte("clement"),
+	[]byte("clever"),
+	[]byte("cliff"),
+	[]byte("clique"),
+	[]byte("clock"),
+	[]byte("clomp"),
+	[]byte("clot"),
+	[]byte("cloy"),
+	[]byte("clump"),
+	[]byte("coach"),
+	[]byte("coast"),
+	[]byte("cobalt"),
+	[]byte("cobweb"),
+	[]byte("coco"),
+	[]byte("codfish"),
+	[]byte("codpiece"),
+	[]byte("coerce"),
+	[]byte("cogent"),
+	[]byte("cohabit"),
+	[]byte("coil"),
+	[]byte("coke"),
+	[]byte("coleus"),
+	[]byte("colt"),
+	[]byte("come"),
+	[]byte("compel"),
+	[]byte("cone"),
+	[]byte("conjoin"),
+	[]byte("consul"),
+	[]byte("cook"),
+	[]byte("coot"),
+	[]byte("copra"),
+	[]byte("copy"),
+	[]byte("cord"),
+	[]byte("corn"),
+	[]byte("corset"),
+	[]byte("coryza"),
+	[]byte("cost"),
+	[]byte("couch"),
+	[]byte("coup"),
+	[]byte("cove"),
+	[]byte("cowgirl"),
+	[]byte("cowpea"),
+	[]byte("coxswain"),
+	[]byte("cozy"),
+	[]byte("craft"),
+	[]byte("crape"),
+	[]byte("craw"),
+	[]byte("creche"),
+	[]byte("cremate"),
+	[]byte("crete"),
+	[]byte("crick"),
+	[]byte("cripple"),
+	[]byte("croci"),
+	[]byte("crop"),
+	[]byte("croup"),
+	[]byte("cruel"),
+	[]byte("crunch"),
+	[]byte("crux"),
+	[]byte("crypt"),
+	[]byte("cubic"),
+	[]byte("cudgel"),
+	[]byte("cull"),
+	[]byte("cumber"),
+	[]byte("cuny"),
+	[]byte("cupid"),
+	[]byte("curb"),
+	[]byte("curia"),
+	[]byte("curse"),
+	[]byte("cusp"),
+	[]byte("cute"),
+	[]byte("cuts"),
+	[]byte("cyanide"),
+	[]byte("cymbal"),
+	[]byte("cyst"),
+	[]byte("dabble"),
+	[]byte("daddy"),
+	[]byte("daft"),
+	[]byte("daily"),
+	[]byte("dais"),
+	[]byte("damage"),
+	[]byte("damsel"),
+	[]byte("dane"),
+	[]byte("danseuse"),
+	[]byte("daring"),
+	[]byte("dart"),
+	[]byte("data"),
+	[]byte("datum"),
+	[]byte("dauphin"),
+	[]byte("dawdle"),
+	[]byte("daylong"),
+	[]byte("dazzle"),
+	[]byte("deal"),
+	[]byte("debar"),
+	[]byte("debt"),
+	[]byte("decide"),
+	[]byte("decry"),
+	[]byte("deed"),
+	[]byte("defat"),
+	[]byte("deform"),
+	[]byte("defy"),
+	[]byte("dehorn"),
+	[]byte("deism"),
+	[]byte("dele"),
+	[]byte("dell"),
+	[]byte("delude"),
+	[]byte("demean"),
+	[]byte("denature"),
+	[]byte("denote"),
+	[]byte("denver"),
+	[]byte("depict"),
+	[]byte("depth"),
+	[]byte("derelict"),
+	[]byte("dervish"),
+	[]byte("desk"),
+	[]byte("destine"),
+	[]byte("dethrone"),
+	[]byte("devalue"),
+	[]byte("dewar"),
+	[]byte("dexter"),
+	[]byte("diadem"),
+	[]byte("diaper"),
+	[]byte("dibble"),
+	[]byte("dicta"),
+	[]byte("didst"),
+	[]byte("diereses"),
+	[]byte("digest"),
+	[]byte("dilate"),
+	[]byte("dilute"),
+	[]byte("dimple"),
+	[]byte("dinky"),
+	[]byte("diocese"),
+	[]byte("diorama"),
+	[]byte("dire"),
+	[]byte("dirndl"),
+	[]byte("disbar"),
+	[]byte("disfavor"),
+	[]byte("disjoin"),
+	[]byte("disown"),
+	[]byte("dissect"),
+	[]byte("dither"),
+	[]byte("dive"),
+	[]byte("dixie"),
+	[]byte("doable"),
+	[]byte("docile"),
+	[]byte("dodder"),
+	[]byte("does"),
+	[]byte("dogcart"),
+	[]byte("doghouse"),
+	[]byte("dogwood"),
+	[]byte("doldrums"),
+	[]byte("dolor"),
+	[]byte("dome"),
+	[]byte("donkey"),
+	[]byte("doom"),
+	[]byte("dorm"),
+	[]byte("dossier"),
+	[]byte("doth"),
+	[]byte("doug"),
+	[]byte("dowager"),
+	[]byte("dowry"),
+	[]byte("doze"),
+	[]byte("drag"),
+	[]byte("dram"),
+	[]byte("drat"),
+	[]byte("dread"),
+	[]byte("dress"),
+	[]byte("drift"),
+	[]byte("drive"),
+	[]byte("drone"),
+	[]byte("drought"),
+	[]byte("drudge"),
+	[]byte("drum"),
+	[]byte("dual"),
+	[]byte("ducal"),
+	[]byte("dude"),
+	[]byte("duet"),
+	[]byte("dulcet"),
+	[]byte("duma"),
+	[]byte("dump"),
+	[]byte("dunk"),
+	[]byte("durable"),
+	[]byte("durst"),
+	[]byte("duteous"),
+	[]byte("dwell"),
+	[]byte("dyer"),
+	[]byte("dyne"),
+	[]byte("earache"),
+	[]byte("earmark"),
+	[]byte("earshot"),
+	[]byte("easily"),
+	[]byte("eaten"),
+	[]byte("echelon"),
+	[]byte("eclipse"),
+	[]byte("ecstasy"),
+	[]byte("eddy"),
+	[]byte("edging"),
+	[]byte("edify"),
+	[]byte("edwin"),
+	[]byte("effect"),
+	[]byte("effuse"),
+	[]byte("eggnog"),
+	[]byte("egoism"),
+	[]byte("eider"),
+	[]byte("either"),
+	[]byte("elastic"),
+	[]byte("elder"),
+	[]byte("elephant"),
+	[]byte("elide"),
+	[]byte("elixir"),
+	[]byte("elongate"),
+	[]byte("elsie"),
+	[]byte("elvish"),
+	[]byte("emanate"),
+	[]byte("emblem"),
+	[]byte("emend"),
+	[]byte("emil"),
+	[]byte("emit"),
+	[]byte("empath"),
+	[]byte("employ"),
+	[]byte("empty"),
+	[]byte("enact"),
+	[]byte("enchain"),
+	[]byte("encrust"),
+	[]byte("endgame"),
+	[]byte("endow"),
+	[]byte("endways"),
+	[]byte("enfeeble"),
+	[]byte("engender"),
+	[]byte("engraft"),
+	[]byte("enisle"),
+	[]byte("enlist"),
+	[]byte("ennui"),
+	[]byte("enquire"),
+	[]byte("ensconce"),
+	[]byte("enslave"),
+	[]byte("entail"),
+	[]byte("entomb"),
+	[]byte("envious"),
+	[]byte("enzyme"),
+	[]byte("ephesus"),
+	[]byte("epilog"),
+	[]byte("epoch"),
+	[]byte("equerry"),
+	[]byte("erelong"),
+	[]byte("ermine"),
+	[]byte("errand"),
+	[]byte("erudite"),
+	[]byte("eschew"),
+	[]byte("esdras"),
+	[]byte("espalier"),
+	[]byte("espy"),
+	[]byte("estate"),
+	[]byte("estop"),
+	[]byte("eternal"),
+	[]byte("ethnic"),
+	[]byte("etude"),
+	[]byte("eulogy"),
+	[]byte("eurasia"),
+	[]byte("evade"),
+	[]byte("even"),
+	[]byte("evil"),
+	[]byte("evoke"),
+	[]byte("exalt"),
+	[]byte("exchange"),
+	[]byte("excuse"),
+	[]byte("exequies"),
+	[]byte("exhibit"),
+	[]byte("exile"),
+	[]byte("exogam"),
+	[]byte("expel"),
+	[]byte("express"),
+	[]byte("extinct"),
+	[]byte("exult"),
+	[]byte("eyeful"),
+	[]byte("eyesore"),
+	[]byte("ezekiel"),
+	[]byte("fabric"),
+	[]byte("facial"),
+	[]byte("faecal"),
+	[]byte("fail"),
+	[]byte("fajita"),
+	[]byte("fall"),
+	[]byte("family"),
+	[]byte("fandango"),
+	[]byte("fanlight"),
+	[]byte("farad"),
+	[]byte("farina"),
+	[]byte("farther"),
+	[]byte("fatal"),
+	[]byte("fatigue"),
+	[]byte("faucet"),
+	[]byte("fawn"),
+	[]byte("fear"),
+	[]byte("febrile"),
+	[]byte("fedora"),
+	[]byte("feel"),
+	[]byte("feldspar"),
+	[]byte("felt"),
+	[]byte("fence"),
+	[]byte("fern"),
+	[]byte("ferule"),
+	[]byte("fetal"),
+	[]byte("fetlock"),
+	[]byte("fever"),
+	[]byte("fiance"),
+	[]byte("fibre"),
+	[]byte("fiddle"),
+	[]byte("fief"),
+	[]byte("fiesta"),
+	[]byte("figment"),
+	[]byte("filament"),
+	[]byte("filial"),
+	[]byte("filth"),
+	[]byte("fine"),
+	[]byte("fink"),
+	[]byte("fire"),
+	[]byte("firth"),
+	[]byte("fission"),
+	[]byte("fitting"),
+	[]byte("fixity"),
+	[]byte("flab"),
+	[]byte("flak"),
+	[]byte("flare"),
+	[]byte("flaunt"),
+	[]byte("flay"),
+	[]byte("flee"),
+	[]byte("flew"),
+	[]byte("flight"),
+	[]byte("flirt"),
+	[]byte("floc"),
+	[]byte("flop"),
+	[]byte("flour"),
+	[]byte("fluff"),
+	[]byte("flume"),
+	[]byte("flush"),
+	[]byte("flyable"),
+	[]byte("flypaper"),
+	[]byte("foam"),
+	[]byte("fodder"),
+	[]byte("fogy"),
+	[]byte("fold"),
+	[]byte("foment"),
+	[]byte("food"),
+	[]byte("forbad"),
+	[]byte("forfeit"),
+	[]byte("forlorn"),
+	[]byte("forum"),
+	[]byte("fought"),
+	[]byte("fovea"),
+	[]byte("foxhole"),
+	[]byte("fracas"),
+	[]byte("franc"),
+	[]byte("frau"),
+	[]byte("freckle"),
+	[]byte("french"),
+	[]byte("fret"),
+	[]byte("friction"),
+	[]byte("frill"),
+	[]byte("fritter"),
+	[]byte("frolic"),
+	[]byte("froth"),
+	[]byte("froze"),
+	[]byte("frusta"),
+	[]byte("fudge"),
+	[]byte("fugue"),
+	[]byte("fulfil"),
+	[]byte("fumble"),
+	[]byte("fund"),
+	[]byte("funny"),
+	[]byte("furnace"),
+	[]byte("fury"),
+	[]byte("fusion"),
+	[]byte("future"),
+	[]byte("gabfest"),
+	[]byte("gadfly"),
+	[]byte("gage"),
+	[]byte("gain"),
+	[]byte("galilee"),
+	[]byte("galvanic"),
+	[]byte("gamma"),
+	[]byte("gander"),
+	[]byte("gaol"),
+	[]byte("garcon"),
+	[]byte("garlic"),
+	[]byte("garter"),
+	[]byte("gasify"),
+	[]byte("gasp"),
+	[]byte("gather"),
+	[]byte("gauge"),
+	[]byte("gauze"),
+	[]byte("gawk"),
+	[]byte("gear"),
+	[]byte("gelable"),
+	[]byte("gemstone"),
+	[]byte("genocide"),
+	[]byte("genus"),
+	[]byte("geometr"),
+	[]byte("germ"),
+	[]byte("gets"),
+	[]byte("geyser"),
+	[]byte("gherkin"),
+	[]byte("giant"),
+	[]byte("giddy"),
+	[]byte("gigolo"),
+	[]byte("gimbal"),
+	[]byte("gimpy"),
+	[]byte("ginmill"),
+	[]byte("gird"),
+	[]byte("give"),
+	[]byte("glabrous"),
+	[]byte("gland"),
+	[]byte("glaze"),
+	[]byte("glen"),
+	[]byte("glint"),
+	[]byte("glob"),
+	[]byte("gloss"),
+	[]byte("gloze"),
+	[]byte("glum"),
+	[]byte("glyph"),
+	[]byte("gnaw"),
+	[]byte("goad"),
+	[]byte("goblet"),
+	[]byte("godly"),
+	[]byte("goggle"),
+	[]byte("golf"),
+	[]byte("gone"),
+	[]byte("good"),
+	[]byte("gopher"),
+	[]byte("gorse"),
+	[]byte("gospel"),
+	[]byte("gouge"),
+	[]byte("govern"),
+	[]byte("grace"),
+	[]byte("grail"),
+	[]byte("grasp"),
+	[]byte("graze"),
+	[]byte("greed"),
+	[]byte("grenada"),
+	[]byte("grief"),
+	[]byte("grin"),
+	[]byte("grizzle"),
+	[]byte("groin"),
+	[]byte("gross"),
+	[]byte("grow"),
+	[]byte("gruff"),
+	[]byte("guam"),
+	[]byte("guerdon"),
+	[]byte("guild"),
+	[]byte("gulch"),
+	[]byte("gull"),
+	[]byte("gumption"),
+	[]byte("gunk"),
+	[]byte("gunshot"),
+	[]byte("guru"),
+	[]byte("gutsy"),
+	[]byte("gwen"),
+	[]byte("gymnast"),
+	[]byte("gyve"),
+	[]byte("habit"),
+	[]byte("hades"),
+	[]byte("haggai"),
+	[]byte("hail"),
+	[]byte("halberd"),
+	[]byte("halibut"),
+	[]byte("halve"),
+	[]byte("hamlet"),
+	[]byte("hand"),
+	[]byte("hans"),
+	[]byte("harass"),
+	[]byte("hark"),
+	[]byte("harp"),
+	[]byte("harvard"),
+	[]byte("hast"),
+	[]byte("hatful"),
+	[]byte("hauberk"),
+	[]byte("haunt"),
+	[]byte("having"),
+	[]byte("hawser"),
+	[]byte("hayloft"),
+	[]byte("hazard"),
+	[]byte("heal"),
+	[]byte("heave"),
+	[]byte("hectic"),
+	[]byte("heel"),
+	[]byte("hegira"),
+	[]byte("heir"),
+	[]byte("helix"),
+	[]byte("help"),
+	[]byte("hemp"),
+	[]byte("hepcat"),
+	[]byte("herd"),
+	[]byte("hernia"),
+	[]byte("hers"),
+	[]byte("hewn"),
+	[]byte("hiatus"),
+	[]byte("hick"),
+	[]byte("hierarch"),
+	[]byte("hike"),
+	[]byte("hilum"),
+	[]byte("hinge"),
+	[]byte("hire"),
+	[]byte("history"),
+	[]byte("hoagy"),
+	[]byte("hobby"),
+	[]byte("hoecake"),
+	[]byte("hogwash"),
+	[]byte("hole"),
+	[]byte("hologram"),
+	[]byte("homage"),
+	[]byte("homolog"),
+	[]byte("honk"),
+	[]byte("hooey"),
+	[]byte("hoop"),
+	[]byte("hooves"),
+	[]byte("horizon"),
+	[]byte("horrid"),
+	[]byte("hosiery"),
+	[]byte("hotel"),
+	[]byte("hotshot"),
+	[]byte("hove"),
+	[]byte("howitzer"),
+	[]byte("huarache"),
+	[]byte("huck"),
+	[]byte("huge"),
+	[]byte("hulk"),
+	[]byte("humdrum"),
+	[]byte("humor"),
+	[]byte("hundred"),
+	[]byte("hurd"),
+	[]byte("husband"),
+	[]byte("hustle"),
+	[]byte("hyacinth"),
+	[]byte("hyde"),
+	[]byte("hying"),
+	[]byte("hyphen"),
+	[]byte("hysteria"),
+	[]byte("ibid"),
+	[]byte("iceland"),
+	[]byte("icing"),
+	[]byte("idea"),
+	[]byte("ides"),
+	[]byte("idly"),
+	[]byte("igloo"),
+	[]byte("iguana"),
+	[]byte("illness"),
+	[]byte("imbibe"),
+	[]byte("immanent"),
+	[]byte("immune"),
+	[]byte("imply"),
+	[]byte("inane"),
+	[]byte("inca"),
+	[]byte("incise"),
+	[]byte("incur"),
+	[]byte("indue"),
+	[]byte("inert"),
+	[]byte("infix"),
+	[]byte("infuse"),
+	[]byte("ingot"),
+	[]byte("inhibit"),
+	[]byte("initial"),
+	[]byte("inkblot"),
+	[]byte("inkwell"),
+	[]byte("inmate"),
+	[]byte("inning"),
+	[]byte("inquest"),
+	[]byte("inscribe"),
+	[]byte("insole"),
+	[]byte("intact"),
+	[]byte("introit"),
+	[]byte("invade"),
+	[]byte("invoke"),
+	[]byte("iota"),
+	[]byte("iraq"),
+	[]byte("iridium"),
+	[]byte("iron"),
+	[]byte("isaiah"),
+	[]byte("isobar"),
+	[]byte("israel"),
+	[]byte("italy"),
+	[]byte("iterate"),
+	[]byte("jabber"),
+	[]byte("jacquard"),
+	[]byte("jail"),
+	[]byte("jalap"),
+	[]byte("james"),
+	[]byte("january"),
+	[]byte("jargon"),
+	[]byte("java"),
+	[]byte("jaywalk"),
+	[]byte("jean"),
+	[]byte("jehovah"),
+	[]byte("jenny"),
+	[]byte("jerk"),
+	[]byte("jest"),
+	[]byte("jetty"),
+	[]byte("jiffy"),
+	[]byte("jilt"),
+	[]byte("jitney"),
+	[]byte("joan"),
+	[]byte("jocose"),
+	[]byte("joey"),
+	[]byte("joist"),
+	[]byte("jonah"),
+	[]byte("josh"),
+	[]byte("joule"),
+	[]byte("jove"),
+	[]byte("joyous"),
+	[]byte("judaic"),
+	[]byte("judge"),
+	[]byte("jugate"),
+	[]byte("juice"),
+	[]byte("julep"),
+	[]byte("junco"),
+	[]byte("junk"),
+	[]byte("jurist"),
+	[]byte("jute"),
+	[]byte("kahn"),
+	[]byte("kamikaze"),
+	[]byte("kansas"),
+	[]byte("kappa"),
+	[]byte("karma"),
+	[]byte("kava"),
+	[]byte("kebab"),
+	[]byte("keep"),
+	[]byte("keno"),
+	[]byte("kept"),
+	[]byte("kerosene"),
+	[]byte("ketch"),
+	[]byte("keyhole"),
+	[]byte("keyword"),
+	[]byte("khedive"),
+	[]byte("kick"),
+	[]byte("kiev"),
+	[]byte("kilt"),
+	[]byte("kinfolk"),
+	[]byte("kiosk"),
+	[]byte("kirk"),
+	[]byte("kitchen"),
+	[]byte("klan"),
+	[]byte("knack"),
+	[]byte("knee"),
+	[]byte("knife"),
+	[]byte("knobbed"),
+	[]byte("know"),
+	[]byte("kobold"),
+	[]byte("kolinsky"),
+	[]byte("koran"),
+	[]byte("kowtow"),
+	[]byte("kremlin"),
+	[]byte("ktext"),
+	[]byte("kumquat"),
+	[]byte("kyat"),
+	[]byte("labial"),
+	[]byte("lace"),
+	[]byte("lacquer"),
+	[]byte("lacuna"),
+	[]byte("lading"),
+	[]byte("laggard"),
+	[]byte("lair"),
+	[]byte("lame"),
+	[]byte("lance"),
+	[]byte("lank"),
+	[]byte("lanyard"),
+	[]byte("lapdog"),
+	[]byte("lappet"),
+	[]byte("lapwing"),
+	[]byte("lard"),
+	[]byte("lark"),
+	[]byte("lascar"),
+	[]byte("last"),
+	[]byte("lath"),
+	[]byte("latvia"),
+	[]byte("laurel"),
+	[]byte("lavish"),
+	[]byte("lawless"),
+	[]byte("lawyer"),
+	[]byte("layoff"),
+	[]byte("laze"),
+	[]byte("leaf"),
+	[]byte("lean"),
+	[]byte("leather"),
+	[]byte("lector"),
+	[]byte("leer"),
+	[]byte("left"),
+	[]byte("leghorn"),
+	[]byte("leisure"),
+	[]byte("lend"),
+	[]byte("lent"),
+	[]byte("leper"),
+	[]byte("lesk"),
+	[]byte("letdown"),
+	[]byte("letup"),
+	[]byte("levy"),
+	[]byte("lexica"),
+	[]byte("libation"),
+	[]byte("libya"),
+	[]byte("lick"),
+	[]byte("lief"),
+	[]byte("lieu"),
+	[]byte("light"),
+	[]byte("lila"),
+	[]byte("limb"),
+	[]byte("limn"),
+	[]byte("lind"),
+	[]byte("link"),
+	[]byte("lint"),
+	[]byte("liquid"),
+	[]byte("lisle"),
+	[]byte("litany"),
+	[]byte("litmus"),
+	[]byte("livable"),
+	[]byte("llama"),
+	[]byte("loaf"),
+	[]byte("loaves"),
+	[]byte("lobster"),
+	[]byte("lock"),
+	[]byte("lodge"),
+	[]byte("logic"),
+	[]byte("loin"),
+	[]byte("loll"),
+	[]byte("look"),
+	[]byte("loop"),
+	[]byte("lopsided"),
+	[]byte("lorry"),
+	[]byte("lost"),
+	[]byte("lottery"),
+	[]byte("lour"),
+	[]byte("love"),
+	[]byte("lowe"),
+	[]byte("luau"),
+	[]byte("luck"),
+	[]byte("luff"),
+	[]byte("lull"),
+	[]byte("luminary"),
+	[]byte("lunch"),
+	[]byte("lupine"),
+	[]byte("lurid"),
+	[]byte("lusty"),
+	[]byte("luxury"),
+	[]byte("lyle"),
+	[]byte("lynn"),
+	[]byte("lyre"),
+	[]byte("mace"),
+	[]byte("madam"),
+	[]byte("madhouse"),
+	[]byte("madras"),
+	[]byte("magazine"),
+	[]byte("magi"),
+	[]byte("magus"),
+	[]byte("mail"),
+	[]byte("maize"),
+	[]byte("malay"),
+	[]byte("mall"),
+	[]byte("mamma"),
+	[]byte("mane"),
+	[]byte("mania"),
+	[]byte("manor"),
+	[]byte("manta"),
+	[]byte("maori"),
+	[]byte("marble"),
+	[]byte("maria"),
+	[]byte("marmot"),
+	[]byte("marry"),
+	[]byte("marx"),
+	[]byte("mash"),
+	[]byte("mass"),
+	[]byte("mate"),
+	[]byte("matte"),
+	[]byte("maul"),
+	[]byte("maverick"),
+	[]byte("maya"),
+	[]byte("mayo"),
+	[]byte("mead"),
+	[]byte("measle"),
+	[]byte("medal"),
+	[]byte("medulla"),
+	[]byte("megaton"),
+	[]byte("melee"),
+	[]byte("melt"),
+	[]byte("memphis"),
+	[]byte("menhaden"),
+	[]byte("menu"),
+	[]byte("mere"),
+	[]byte("merman"),
+	[]byte("mescal"),
+	[]byte("mesquite"),
+	[]byte("mete"),
+	[]byte("metre"),
+	[]byte("mexico"),
+	[]byte("miasma"),
+	[]byte("micro"),
+	[]byte("midmost"),
+	[]byte("midst"),
+	[]byte("miff"),
+	[]byte("mikado"),
+	[]byte("mild"),
+	[]byte("mill"),
+	[]byte("mime"),
+	[]byte("mince"),
+	[]byte("mini"),
+	[]byte("minster"),
+	[]byte("mira"),
+	[]byte("misapply"),
+	[]byte("misfit"),
+	[]byte("mismatch"),
+	[]byte("misquote"),
+	[]byte("misuse"),
+	[]byte("mitre"),
+	[]byte("mizzen"),
+	[]byte("mobile"),
+	[]byte("mock"),
+	[]byte("mogul"),
+	[]byte("moire"),
+	[]byte("mole"),
+	[]byte("moly"),
+	[]byte("monday"),
+	[]byte("monied"),
+	[]byte("monsoon"),
+	[]byte("moon"),
+	[]byte("mope"),
+	[]byte("morcar"),
+	[]byte("moribund"),
+	[]byte("morph"),
+	[]byte("mosaic"),
+	[]byte("mosque"),
+	[]byte("moth"),
+	[]byte("motor"),
+	[]byte("mound"),
+	[]byte("move"),
+	[]byte("mucilage"),
+	[]byte("mudd"),
+	[]byte("mufti"),
+	[]byte("mulatto"),
+	[]byte("mull"),
+	[]byte("mumps"),
+	[]byte("mung"),
+	[]byte("murk"),
+	[]byte("muse"),
+	[]byte("muslim"),
+	[]byte("mute"),
+	[]byte("muumuu"),
+	[]byte("myna"),
+	[]byte("myrmidon"),
+	[]byte("mystic"),
+	[]byte("nacelle"),
+	[]byte("nagasaki"),
+	[]byte("naif"),
+	[]byte("naive"),
+	[]byte("napalm"),
+	[]byte("naples"),
+	[]byte("naris"),
+	[]byte("nary"),
+	[]byte("nasty"),
+	[]byte("natty"),
+	[]byte("nausea"),
+	[]byte("navigate"),
+	[]byte("neal"),
+	[]byte("nebraska"),
+	[]byte("nectar"),
+	[]byte("neglect"),
+	[]byte("neil"),
+	[]byte("nemesis"),
+	[]byte("neoteny"),
+	[]byte("nepotism"),
+	[]byte("nerve"),
+	[]byte("nettle"),
+	[]byte("nevada"),
+	[]byte("newborn"),
+	[]byte("newish"),
+	[]byte("news"),
+	[]byte("niacin"),
+	[]byte("nice"),
+	[]byte("niece"),
+	[]byte("niggle"),
+	[]byte("nimbi"),
+	[]byte("ninth"),
+	[]byte("nisei"),
+	[]byte("nitwit"),
+	[]byte("noble"),
+	[]byte("noddy"),
+	[]byte("noggin"),
+	[]byte("nomad"),
+	[]byte("none"),
+	[]byte("nonskid"),
+	[]byte("noon"),
+	[]byte("norm"),
+	[]byte("nose"),
+	[]byte("notch"),
+	[]byte("nougat"),
+	[]byte("nova"),
+	[]byte("nowhere"),
+	[]byte("nuance"),
+	[]byte("nuclei"),
+	[]byte("nugatory"),
+	[]byte("numb"),
+	[]byte("nuncio"),
+	[]byte("nurture"),
+	[]byte("nutria"),
+	[]byte("nylon"),
+	[]byte("oakum"),
+	[]byte("oases"),
+	[]byte("oatmeal"),
+	[]byte("obduracy"),
+	[]byte("obese"),
+	[]byte("oblate"),
+	[]byte("oboist"),
+	[]byte("obsolete"),
+	[]byte("obtuse"),
+	[]byte("occasion"),
+	[]byte("ocean"),
+	[]byte("octet"),
+	[]byte("oddball"),
+	[]byte("odin"),
+	[]byte("odor"),
+	[]byte("offbeat"),
+	[]byte("offload"),
+	[]byte("ofttimes"),
+	[]byte("ohmmeter"),
+	[]byte("oilseed"),
+	[]byte("okinawa"),
+	[]byte("olden"),
+	[]byte("oleander"),
+	[]byte("oligarch"),
+	[]byte("olympic"),
+	[]byte("omelet"),
+	[]byte("omission"),
+	[]byte("oncolog"),
+	[]byte("onetime"),
+	[]byte("only"),
+	[]byte("ontario"),
+	[]byte("onward"),
+	[]byte("oozy"),
+	[]byte("opec"),
+	[]byte("opine"),
+	[]byte("oppress"),
+	[]byte("opulent"),
+	[]byte("orange"),
+	[]byte("ordain"),
+	[]byte("ordure"),
+	[]byte("orgulous"),
+	[]byte("orion"),
+	[]byte("ormolu"),
+	[]byte("orotund"),
+	[]byte("ortolan"),
+	[]byte("osier"),
+	[]byte("osprey"),
+	[]byte("osteolog"),
+	[]byte("otiose"),
+	[]byte("otto"),
+	[]byte("ounce"),
+	[]byte("outcry"),
+	[]byte("outgo"),
+	[]byte("outmoded"),
+	[]byte("outwit"),
+	[]byte("oven"),
+	[]byte("ovum"),
+	[]byte("owner"),
+	[]byte("oxcart"),
+	[]byte("oxide"),
+	[]byte("ozark"),
+	[]byte("pacify"),
+	[]byte("padlock"),
+	[]byte("page"),
+	[]byte("pail"),
+	[]byte("pajamas"),
+	[]byte("palfrey"),
+	[]byte("palomino"),
+	[]byte("pampa"),
+	[]byte("panda"),
+	[]byte("panic"),
+	[]byte("pant"),
+	[]byte("papoose"),
+	[]byte("papyri"),
+	[]byte("pard"),
+	[]byte("park"),
+	[]byte("parry"),
+	[]byte("pasadena"),
+	[]byte("pass"),
+	[]byte("path"),
+	[]byte("patsy"),
+	[]byte("pauper"),
+	[]byte("paving"),
+	[]byte("payload"),
+	[]byte("peace"),
+	[]byte("peal"),
+	[]byte("peat"),
+	[]byte("peck"),
+	[]byte("pedal"),
+	[]byte("peduncle"),
+	[]byte("peep"),
+	[]byte("pegboard"),
+	[]byte("peleg"),
+	[]byte("pelt"),
+	[]byte("pence"),
+	[]byte("penknife"),
+	[]byte("pension"),
+	[]byte("peon"),
+	[]byte("pepsin"),
+	[]byte("perfect"),
+	[]byte("perjure"),
+	[]byte("perplex"),
+	[]byte("pervade"),
+	[]byte("pest"),
+	[]byte("petri"),
+	[]byte("pewter"),
+	[]byte("phantom"),
+	[]byte("pheasant"),
+	[]byte("phiz"),
+	[]byte("phoebe"),
+	[]byte("phrase"),
+	[]byte("piaster"),
+	[]byte("piccolo"),
+	[]byte("picot"),
+	[]byte("piebald"),
+	[]byte("pier"),
+	[]byte("pigeon"),
+	[]byte("pigpen"),
+	[]byte("pilaster"),
+	[]byte("pilgrim"),
+	[]byte("pilsner"),
+	[]byte("pinafore"),
+	[]byte("ping"),
+	[]byte("pinnace"),
+	[]byte("pinup"),
+	[]byte("pipe"),
+	[]byte("pippin"),
+	[]byte("pismire"),
+	[]byte("pitfall"),
+	[]byte("pittance"),
+	[]byte("pixie"),
+	[]byte("plaid"),
+	[]byte("plat"),
+	[]byte("plea"),
+	[]byte("plena"),
+	[]byte("plexus"),
+	[]byte("plinth"),
+	[]byte("plough"),
+	[]byte("pluck"),
+	[]byte("plural"),
+	[]byte("plywood"),
+	[]byte("podia"),
+	[]byte("pogrom"),
+	[]byte("poise"),
+	[]byte("pole"),
+	[]byte("poll"),
+	[]byte("pomade"),
+	[]byte("pond"),
+	[]byte("pontiff"),
+	[]byte("pooh"),
+	[]byte("pope"),
+	[]byte("popover"),
+	[]byte("pore"),
+	[]byte("porridge"),
+	[]byte("posh"),
+	[]byte("posy"),
+	[]byte("potful"),
+	[]byte("potomac"),
+	[]byte("pouch"),
+	[]byte("pout"),
+	[]byte("powwow"),
+	[]byte("praise"),
+	[]byte("prate"),
+	[]byte("prebend"),
+	[]byte("preen"),
+	[]byte("prejudge"),
+	[]byte("prep"),
+	[]byte("prewar"),
+	[]byte("priest"),
+	[]byte("prior"),
+	[]byte("prize"),
+	[]byte("proem"),
+	[]byte("project"),
+	[]byte("prone"),
+	[]byte("prose"),
+	[]byte("prow"),
+	[]byte("prurient"),
+	[]byte("psych"),
+	[]byte("public"),
+	[]byte("pudgy"),
+	[]byte("pugilism"),
+	[]byte("pulmotor"),
+	[]byte("pumice"),
+	[]byte("punch"),
+	[]byte("punk"),
+	[]byte("pupa"),
+	[]byte("purchase"),
+	[]byte("purim"),
+	[]byte("purse"),
+	[]byte("puss"),
+	[]byte("putrid"),
+	[]byte("pygmy"),
+	[]byte("pyorrhea"),
+	[]byte("pyrrhic"),
+	[]byte("quack"),
+	[]byte("quahog"),
+	[]byte("quanta"),
+	[]byte("quaver"),
+	[]byte("queen"),
+	[]byte("quest"),
+	[]byte("quick"),
+	[]byte("quint"),
+	[]byte("quisling"),
+	[]byte("quiz"),
+	[]byte("quorum"),
+	[]byte("rabid"),
+	[]byte("rack"),
+	[]byte("radii"),
+	[]byte("rage"),
+	[]byte("ragtime"),
+	[]byte("raiment"),
+	[]byte("raja"),
+	[]byte("rally"),
+	[]byte("ramp"),
+	[]byte("rang"),
+	[]byte("rant"),
+	[]byte("rare"),
+	[]byte("rasp"),
+	[]byte("rather"),
+	[]byte("raucous"),
+	[]byte("ravine"),
+	[]byte("rayon"),
+	[]byte("razz"),
+	[]byte("real"),
+	[]byte("reason"),
+	[]byte("reborn"),
+	[]byte("recipe"),
+	[]byte("recruit"),
+	[]byte("redbud"),
+	[]byte("redhead"),
+	[]byte("reduce"),
+	[]byte("reek"),
+	[]byte("refit"),
+	[]byte("refuge"),
+	[]byte("regnal"),
+	[]byte("rehearse"),
+	[]byte("reject"),
+	[]byte("relic"),
+	[]byte("remit"),
+	[]byte("rend"),
+	[]byte("rent"),
+	[]byte("reply"),
+	[]byte("repute"),
+	[]byte("rescue"),
+	[]byte("respect"),
+	[]byte("retch"),
+	[]byte("return"),
+	[]byte("review"),
+	[]byte("reward"),
+	[]byte("rheolog"),
+	[]byte("rhine"),
+	[]byte("rhubarb"),
+	[]byte("ribald"),
+	[]byte("rick"),
+	[]byte("ridge"),
+	[]byte("riffle"),
+	[]byte("right"),
+	[]byte("rill"),
+	[]byte("ring"),
+	[]byte("riot"),
+	[]byte("ripple"),
+	[]byte("risible"),
+	[]byte("ritual"),
+	[]byte("riyal"),
+	[]byte("roan"),
+	[]byte("robe"),
+	[]byte("rock"),
+	[]byte("rogation"),
+	[]byte("roil"),
+	[]byte("romans"),
+	[]byte("rood"),
+	[]byte("roost"),
+	[]byte("rose"),
+	[]byte("rosy"),
+	[]byte("rotor"),
+	[]byte("roue"),
+	[]byte("rouse"),
+	[]byte("rowdy"),
+	[]byte("royal"),
+	[]byte("rubicund"),
+	[]byte("ruckus"),
+	[]byte("rudy"),
+	[]byte("rugged"),
+	[]byte("rumania"),
+	[]byte("rummy"),
+	[]byte("rundown"),
+	[]byte("runny"),
+	[]byte("runt"),
+	[]byte("rupture"),
+	[]byte("rusk"),
+	[]byte("ruth"),
+	[]byte("sable"),
+	[]byte("sack"),
+	[]byte("sadly"),
+	[]byte("saga"),
+	[]byte("sahara"),
+	[]byte("sail"),
+	[]byte("salad"),
+	[]byte("sally"),
+	[]byte("salt"),
+	[]byte("samba"),
+	[]byte("samuel"),
+	[]byte("sang"),
+	[]byte("santiago"),
+	[]byte("sapwood"),
+	[]byte("sari"),
+	[]byte("sassy"),
+	[]byte("satin"),
+	[]byte("sauce"),
+	[]byte("sausage"),
+	[]byte("saving"),
+	[]byte("sawfly"),
+	[]byte("sawtooth"),
+	[]byte("says"),
+	[]byte("scald"),
+	[]byte("scapula"),
+	[]byte("scene"),
+	[]byte("schnapps"),
+	[]byte("scimitar"),
+	[]byte("scoff"),
+	[]byte("scope"),
+	[]byte("scow"),
+	[]byte("scroll"),
+	[]byte("scuff"),
+	[]byte("scurf"),
+	[]byte("seabird"),
+	[]byte("seahorse"),
+	[]byte("seaport"),
+	[]byte("seat"),
+	[]byte("seclude"),
+	[]byte("secure"),
+	[]byte("sediment"),
+	[]byte("seek"),
+	[]byte("seer"),
+	[]byte("segment"),
+	[]byte("seize"),
+	[]byte("sell"),
+	[]byte("semantic"),
+	[]byte("send"),
+	[]byte("senor"),
+	[]byte("sepal"),
+	[]byte("sequel"),
+	[]byte("serf"),
+	[]byte("serolog"),
+	[]byte("serum"),
+	[]byte("setback"),
+	[]byte("seven"),
+	[]byte("sewn"),
+	[]byte("shack"),
+	[]byte("shah"),
+	[]byte("shank"),
+	[]byte("shave"),
+	[]byte("shed"),
+	[]byte("shekel"),
+	[]byte("shew"),
+	[]byte("shim"),
+	[]byte("shiva"),
+	[]byte("shod"),
+	[]byte("shop"),
+	[]byte("shove"),
+	[]byte("shriek"),
+	[]byte("shudder"),
+	[]byte("shyly"),
+	[]byte("sibilant"),
+	[]byte("sick"),
+	[]byte("sidney"),
+	[]byte("siesta"),
+	[]byte("sigma"),
+	[]byte("silent"),
+	[]byte("silo"),
+	[]byte("simmer"),
+	[]byte("sinai"),
+	[]byte("sinful"),
+	[]byte("sinter"),
+	[]byte("sire"),
+	[]byte("sirup"),
+	[]byte("sitcom"),
+	[]byte("siva"),
+	[]byte("sizable"),
+	[]byte("skeet"),
+	[]byte("sketch"),
+	[]byte("skiing"),
+	[]byte("skin"),
+	[]byte("skulk"),
+	[]byte("skyhook"),
+	[]byte("slab"),
+	[]byte("slake"),
+	[]byte("slap"),
+	[]byte("slaw"),
+	[]byte("sleek"),
+	[]byte("sleuth"),
+	[]byte("slid"),
+	[]byte("slip"),
+	[]byte("sloe"),
+	[]byte("slosh"),
+	[]byte("slow"),
+	[]byte("slum"),
+	[]byte("smack"),
+	[]byte("smatter"),
+	[]byte("smirk"),
+	[]byte("smoke"),
+	[]byte("smote"),
+	[]byte("snack"),
+	[]byte("snake"),
+	[]byte("sneak"),
+	[]byte("snide"),
+	[]byte("snitch"),
+	[]byte("snore"),
+	[]byte("snub"),
+	[]byte("soap"),
+	[]byte("sobriety"),
+	[]byte("socrates"),
+	[]byte("soever"),
+	[]byte("soft"),
+	[]byte("soiree"),
+	[]byte("sole"),
+	[]byte("solute"),
+	[]byte("some"),
+	[]byte("sonny"),
+	[]byte("sophism"),
+	[]byte("sordid"),
+	[]byte("sorption"),
+	[]byte("sough"),
+	[]byte("sour"),
+	[]byte("soviet"),
+	[]byte("soya"),
+	[]byte("spain"),
+	[]byte("spasm"),
+	[]byte("spay"),
+	[]byte("sped"),
+	[]byte("sperm"),
+	[]byte("sphinx"),
+	[]byte("spiffy"),
+	[]byte("spin"),
+	[]byte("spleen"),
+	[]byte("spoil"),
+	[]byte("spore"),
+	[]byte("spree"),
+	[]byte("spry"),
+	[]byte("spun"),
+	[]byte("squab"),
+	[]byte("stack"),
+	[]byte("staid"),
+	[]byte("stan"),
+	[]byte("state"),
+	[]byte("stead"),
+	[]byte("stellar"),
+	[]byte("stern"),
+	[]byte("stiff"),
+	[]byte("sting"),
+	[]byte("stoat"),
+	[]byte("stoke"),
+	[]byte("stood"),
+	[]byte("stout"),
+	[]byte("strew"),
+	[]byte("stub"),
+	[]byte("stultify"),
+	[]byte("sturdy"),
+	[]byte("style"),
+	[]byte("styx"),
+	[]byte("sublet"),
+	[]byte("subside"),
+	[]byte("subway"),
+	[]byte("sucre"),
+	[]byte("suds"),
+	[]byte("suffer"),
+	[]byte("suit"),
+	[]byte("sully"),
+	[]byte("sumer"),
+	[]byte("sunbeam"),
+	[]byte("sunk"),
+	[]byte("sunset"),
+	[]byte("supine"),
+	[]byte("sure"),
+	[]byte("surly"),
+	[]byte("surrey"),
+	[]byte("sushi"),
+	[]byte("sustain"),
+	[]byte("swab"),
+	[]byte("swain"),
+	[]byte("swap"),
+	[]byte("sway"),
+	[]byte("swell"),
+	[]byte("swig"),
+	[]byte("swipe"),
+	[]byte("swivel"),
+	[]byte("swoon"),
+	[]byte("sybarite"),
+	[]byte("sylph"),
+	[]byte("sympath"),
+	[]byte("synergy"),
+	[]byte("syracuse"),
+	[]byte("tabby"),
+	[]byte("tacit"),
+	[]byte("tadpole"),
+	[]byte("tahoe"),
+	[]byte("taipei"),
+	[]byte("talc"),
+	[]byte("tall"),
+	[]byte("tamale"),
+	[]byte("tanbark"),
+	[]byte("tanner"),
+	[]byte("taoist"),
+	[]byte("taproom"),
+	[]byte("target"),
+	[]byte("taro"),
+	[]byte("task"),
+	[]byte("tate"),
+	[]byte("taupe"),
+	[]byte("tawdry"),
+	[]byte("taxpayer"),
+	[]byte("teal"),
+	[]byte("tease"),
+	[]byte("tedium"),
+	[]byte("teet"),
+	[]byte("telex"),
+	[]byte("tempi"),
+	[]byte("tenfold"),
+	[]byte("tense"),
+	[]byte("tepid"),
+	[]byte("term"),
+	[]byte("tertiary"),
+	[]byte("tether"),
+	[]byte("text"),
+	[]byte("thames"),
+	[]byte("theater"),
+	[]byte("their"),
+	[]byte("there"),
+	[]byte("they"),
+	[]byte("thigh"),
+	[]byte("this"),
+	[]byte("thorn"),
+	[]byte("three"),
+	[]byte("thud"),
+	[]byte("thunder"),
+	[]byte("thyme"),
+	[]byte("tibet"),
+	[]byte("tidbit"),
+	[]byte("tied"),
+	[]byte("tight"),
+	[]byte("till"),
+	[]byte("timid"),
+	[]byte("tinct"),
+	[]byte("tinful"),
+	[]byte("tinplate"),
+	[]byte("tiny"),
+	[]byte("tiptoe"),
+	[]byte("titan"),
+	[]byte("title"),
+	[]byte("toad"),
+	[]byte("toboggan"),
+	[]byte("toddy"),
+	[]byte("toffee"),
+	[]byte("toggle"),
+	[]byte("token"),
+	[]byte("toll"),
+	[]byte("tome"),
+	[]byte("tonal"),
+	[]byte("tonnage"),
+	[]byte("tool"),
+	[]byte("tope"),
+	[]byte("topnotch"),
+	[]byte("tops"),
+	[]byte("tore"),
+	[]byte("toronto"),
+	[]byte("torsi"),
+	[]byte("toss"),
+	[]byte("touch"),
+	[]byte("tousle"),
+	[]byte("towel"),
+	[]byte("toxemia"),
+	[]byte("trade"),
+	[]byte("traject"),
+	[]byte("trash"),
+	[]byte("tray"),
+	[]byte("trefoil"),
+	[]byte("trend"),
+	[]byte("triad"),
+	[]byte("tried"),
+	[]byte("trim"),
+	[]byte("trireme"),
+	[]byte("triune"),
+	[]byte("troika"),
+	[]byte("troop"),
+	[]byte("trow"),
+	[]byte("trudge"),
+	[]byte("truly"),
+	[]byte("truth"),
+	[]byte("tsunami"),
+	[]byte("tubule"),
+	[]byte("tues"),
+	[]byte("tugboat"),
+	[]byte("tulsa"),
+	[]byte("tumult"),
+	[]byte("tung"),
+	[]byte("turban"),
+	[]byte("turk"),
+	[]byte("turtle"),
+	[]byte("tutelar"),
+	[]byte("twaddle"),
+	[]byte("tweak"),
+	[]byte("twice"),
+	[]byte("twin"),
+	[]byte("twofold"),
+	[]byte("tying"),
+	[]byte("typhus"),
+	[]byte("tyre"),
+	[]byte("udder"),
+	[]byte("ukase"),
+	[]byte("ukulele"),
+	[]byte("ulster"),
+	[]byte("ululate"),
+	[]byte("umiak"),
+	[]byte("unable"),
+	[]byte("unbend"),
+	[]byte("unbuckle"),
+	[]byte("uncle"),
+	[]byte("uncut"),
+	[]byte("undress"),
+	[]byte("uneasy"),
+	[]byte("unesco"),
+	[]byte("unfit"),
+	[]byte("ungainly"),
+	[]byte("unhand"),
+	[]byte("uniaxial"),
+	[]byte("union"),
+	[]byte("unit"),
+	[]byte("unkempt"),
+	[]byte("unlace"),
+	[]byte("unlucky"),
+	[]byte("unnerve"),
+	[]byte("unquiet"),
+	[]byte("unrobe"),
+	[]byte("unseal"),
+	[]byte("unstop"),
+	[]byte("unto"),
+	[]byte("unused"),
+	[]byte("unwind"),
+	[]byte("unyoke"),
+	[]byte("upchuck"),
+	[]byte("upend"),
+	[]byte("uphold"),
+	[]byte("upload"),
+	[]byte("uppish"),
+	[]byte("uproar"),
+	[]byte("upside"),
+	[]byte("uptake"),
+	[]byte("upturn"),
+	[]byte("urban"),
+	[]byte("ureter"),
+	[]byte("urine"),
+	[]byte("usable"),
+	[]byte("useless"),
+	[]byte("using"),
+	[]byte("usurp"),
+	[]byte("utile"),
+	[]byte("uvula"),
+	[]byte("vaccine"),
+	[]byte("vague"),
+	[]byte("vale"),
+	[]byte("valse"),
+	[]byte("vamp"),
+	[]byte("vanful"),
+	[]byte("vantage"),
+	[]byte("vapor"),
+	[]byte("varmint"),
+	[]byte("vary"),
+	[]byte("vassal"),
+	[]byte("vault"),
+	[]byte("vector"),
+	[]byte("vegetal"),
+	[]byte("vein"),
+	[]byte("velour"),
+	[]byte("vend"),
+	[]byte("venom"),
+	[]byte("verb"),
+	[]byte("verify"),
+	[]byte("verse"),
+	[]byte("vesicle"),
+	[]byte("vetch"),
+	[]byte("viable"),
+	[]byte("viaticum"),
+	[]byte("vicar"),
+	[]byte("vicuna"),
+	[]byte("view"),
+	[]byte("vigor"),
+	[]byte("villa"),
+	[]byte("vintage"),
+	[]byte("viral"),
+	[]byte("virtu"),
+	[]byte("vise"),
+	[]byte("vista"),
+	[]byte("vitrify"),
+	[]byte("vixen"),
+	[]byte("vlsi"),
+	[]byte("vogue"),
+	[]byte("volatile"),
+	[]byte("volition"),
+	[]byte("volume"),
+	[]byte("votary"),
+	[]byte("vowel"),
+	[]byte("vulpine"),
+	[]byte("wack"),
+	[]byte("wadi"),
+	[]byte("waft"),
+	[]byte("wagtail"),
+	[]byte("wain"),
+	[]byte("wake"),
+	[]byte("walnut"),
+	[]byte("wand"),
+	[]byte("wapiti"),
+	[]byte("warfare"),
+	[]byte("warm"),
+	[]byte("warsaw"),
+	[]byte("wash"),
+	[]byte("watch"),
+	[]byte("wave"),
+	[]byte("waxy"),
+	[]byte("ways"),
+	[]byte("wean"),
+	[]byte("weather"),
+	[]byte("wedge"),
+	[]byte("ween"),
+	[]byte("weft"),
+	[]byte("weld"),
+	[]byte("welsh"),
+	[]byte("went"),
+	[]byte("west"),
+	[]byte("whack"),
+	[]byte("what"),
+	[]byte("when"),
+	[]byte("whey"),
+	[]byte("while"),
+	[]byte("whip"),
+	[]byte("whiz"),
+	[]byte("whole"),
+	[]byte("whorl"),
+	[]byte("wick"),
+	[]byte("width"),
+	[]byte("wife"),
+	[]byte("wild"),
+	[]byte("will"),
+	[]byte("wimple"),
+	[]byte("wing"),
+	[]byte("winsome"),
+	[]byte("wire"),
+	[]byte("wise"),
+	[]byte("witch"),
+	[]byte("witty"),
+	[]byte("woad"),
+	[]byte("wold"),
+	[]byte("womb"),
+	[]byte("wont"),
+	[]byte("wool"),
+	[]byte("work"),
+	[]byte("worry"),
+	[]byte("wound"),
+	[]byte("wrangle"),
+	[]byte("wreak"),
+	[]byte("wretch"),
+	[]byte("writ"),
+	[]byte("wrought"),
+	[]byte("wynn"),
+	[]byte("xenon"),
+	[]byte("xylem"),
+	[]byte("yale"),
+	[]byte("yanqui"),
+	[]byte("yarrow"),
+	[]byte("yclept"),
+	[]byte("yeast"),
+	[]byte("yeoman"),
+	[]byte("yield"),
+	[]byte("yoghurt"),
+	[]byte("yolk"),
+	[]byte("yosemite"),
+	[]byte("yowl"),
+	[]byte("yucatan"),
+	[]byte("yule"),
+	[]byte("ywca"),
+	[]byte("zany"),
+	[]byte("zebra"),
+	[]byte("zephyr"),
+	[]byte("zeta"),
+	[]byte("zimbabwe"),
+	[]byte("zion"),
+	[]byte("zloty"),
+	[]byte("zone"),
+	[]byte("zoophyte"),
+	[]byte("zurich"),
+}
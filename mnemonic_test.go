@@ -0,0 +1,157 @@
+package atoll
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEncodeMnemonic(t *testing.T) {
+	word := func(i int) string { return string(mnemonicList[i]) }
+
+	// Same bits as BIP39 test vectors, with the indices mapped to this word list
+	cases := []struct {
+		entropy  []byte
+		expected []string
+	}{
+		{
+			// abandon x11 about
+			entropy:  make([]byte, 16),
+			expected: append(strings.Fields(strings.Repeat(word(0)+" ", 11)), word(3)),
+		},
+		{
+			// zoo x11 wrong
+			entropy:  bytes.Repeat([]byte{0xff}, 16),
+			expected: append(strings.Fields(strings.Repeat(word(2047)+" ", 11)), word(2037)),
+		},
+		{
+			// abandon x23 art
+			entropy:  make([]byte, 32),
+			expected: append(strings.Fields(strings.Repeat(word(0)+" ", 23)), word(102)),
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := EncodeMnemonic(tc.entropy)
+		if err != nil {
+			t.Fatalf("EncodeMnemonic() failed: %v", err)
+		}
+
+		if strings.Join(got, " ") != strings.Join(tc.expected, " ") {
+			t.Errorf("Expected %v, got %v", tc.expected, got)
+		}
+	}
+}
+
+func TestDecodeMnemonic(t *testing.T) {
+	for bits := 128; bits <= 256; bits += 32 {
+		words, err := GenerateMnemonic(bits)
+		if err != nil {
+			t.Fatalf("GenerateMnemonic() failed: %v", err)
+		}
+		if len(words) != (bits+bits/32)/11 {
+			t.Errorf("Expected %d words, got %d", (bits+bits/32)/11, len(words))
+		}
+
+		entropy, err := DecodeMnemonic(words)
+		if err != nil {
+			t.Fatalf("DecodeMnemonic() failed: %v", err)
+		}
+		if len(entropy) != bits/8 {
+			t.Errorf("Expected %d bytes, got %d", bits/8, len(entropy))
+		}
+
+		reencoded, err := EncodeMnemonic(entropy)
+		if err != nil {
+			t.Fatalf("EncodeMnemonic() failed: %v", err)
+		}
+		if strings.Join(reencoded, " ") != strings.Join(words, " ") {
+			t.Errorf("Expected %v, got %v", words, reencoded)
+		}
+	}
+
+	// Uppercase and abbreviated words
+	entropy := make([]byte, 20)
+	if _, err := rand.Read(entropy); err != nil {
+		t.Fatal(err)
+	}
+	words, err := EncodeMnemonic(entropy)
+	if err != nil {
+		t.Fatalf("EncodeMnemonic() failed: %v", err)
+	}
+	for i := range words {
+		if i%2 == 0 {
+			words[i] = strings.ToUpper(words[i][:4])
+		}
+	}
+	got, err := DecodeMnemonic(words)
+	if err != nil {
+		t.Fatalf("DecodeMnemonic() failed: %v", err)
+	}
+	if !bytes.Equal(got, entropy) {
+		t.Errorf("Expected %x, got %x", entropy, got)
+	}
+}
+
+func TestInvalidMnemonic(t *testing.T) {
+	for _, n := range []int{0, 12, 33, 36} {
+		if _, err := EncodeMnemonic(make([]byte, n)); err == nil {
+			t.Errorf("Expected invalid entropy length error for %d bytes, got nil", n)
+		}
+	}
+	for _, bits := range []int{-32, 0, 96, 100, 288} {
+		if _, err := GenerateMnemonic(bits); err == nil {
+			t.Errorf("Expected invalid bits error for %d bits, got nil", bits)
+		}
+	}
+
+	words, err := EncodeMnemonic(make([]byte, 16))
+	if err != nil {
+		t.Fatalf("EncodeMnemonic() failed: %v", err)
+	}
+
+	if _, err := DecodeMnemonic(words[:11]); err == nil {
+		t.Error("Expected invalid number of words error, got nil")
+	}
+
+	// Swap the last word for another valid one
+	invalid := append(words[:11:11], string(mnemonicList[4]))
+	if _, err := DecodeMnemonic(invalid); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("Expected checksum error, got %v", err)
+	}
+
+	// Mistype a word
+	typo := append([]string{}, words...)
+	typo[3] = string(mnemonicList[1000][:len(mnemonicList[1000])-1]) + "q"
+	_, err = DecodeMnemonic(typo)
+	if err == nil || !strings.Contains(err.Error(), string(mnemonicList[1000])) {
+		t.Errorf("Expected a suggestion of %q, got %v", mnemonicList[1000], err)
+	}
+}
+
+func TestMnemonicList(t *testing.T) {
+	if len(mnemonicList) != 2048 {
+		t.Fatalf("Expected 2048 words, got %d", len(mnemonicList))
+	}
+
+	prefixes := make(map[string]struct{}, len(mnemonicList))
+	for _, word := range mnemonicList {
+		if len(word) < 4 || len(word) > 8 {
+			t.Errorf("Expected words of 4 to 8 letters, got %q", word)
+		}
+		if _, ok := prefixes[string(word[:4])]; ok {
+			t.Errorf("Repeated prefix %q", word[:4])
+		}
+		prefixes[string(word[:4])] = struct{}{}
+	}
+
+	p := &Passphrase{Length: 6, List: MnemonicList}
+	if _, err := p.Generate(); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	if got := p.Entropy(); math.Abs(got-66) > 1e-9 {
+		t.Errorf("Expected 66, got %f", got)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"slices"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -21,25 +23,141 @@ const (
 	noListType       = "NoList"
 	wordListType     = "WordList"
 	syllableListType = "SyllableList"
+	mnemonicListType = "MnemonicList"
 )
 
+// Case represents the letter case applied to the words of a passphrase.
+type Case uint8
+
+// Passphrase cases.
+const (
+	// KeepCase leaves words as they are.
+	KeepCase Case = iota
+	// LowerCase converts every word to lowercase: correct horse.
+	LowerCase
+	// UpperCase converts every word to uppercase: CORRECT HORSE.
+	UpperCase
+	// TitleCase capitalizes every word: Correct Horse.
+	TitleCase
+	// CamelCase capitalizes every word but the first one: correct Horse.
+	CamelCase
+	// RandomWordCase converts each word to lowercase or uppercase randomly: correct HORSE.
+	RandomWordCase
+	// RandomLetterCase converts each letter to lowercase or uppercase randomly: cOrRecT hOrsE.
+	RandomLetterCase
+)
+
+// PadPosition represents where the padding characters are inserted in a passphrase.
+type PadPosition uint8
+
+// Padding positions.
+const (
+	// PadBefore inserts the characters before the phrase: 42correct-horse.
+	PadBefore PadPosition = iota
+	// PadAfter inserts the characters after the phrase: correct-horse42.
+	PadAfter
+	// PadBetween inserts the characters as a group between two random words: correct-42-horse.
+	PadBetween
+	// PadWord appends the characters to a random word: correct42-horse.
+	PadWord
+)
+
+// Padding represents a group of random characters added to a passphrase.
+type Padding struct {
+	// Group of characters the padding is taken from.
+	Level Level
+	// Number of characters.
+	Count uint64
+	// Position of the characters in the passphrase.
+	Position PadPosition
+}
+
 // Passphrase represents a sequence of words/syllables with a separator between them.
 type Passphrase struct {
 	// List used to generate the passphrase.
 	List list
+	// Source used to generate the words, it takes precedence over List. Word length limits
+	// are not applied to sources.
+	Source WordSource
 	// Words separator.
 	Separator string
-	words     [][]byte
+	// Set of separators, when it's not empty one of them is randomly chosen for each gap
+	// between words and Separator is ignored.
+	Separators []string
+	words      [][]byte
+	// Separators chosen to fit in MaxChars.
+	seps [][]byte
+	// Words of each list grouped by length and the parameters used to build them.
+	classes    map[string]*lengthClasses
+	classesKey string
 	// Words that will be part of the passphrase.
 	Include []string
 	// Words that won't be part of the passphrase.
 	Exclude []string
 	// Number of words in the passphrase.
 	Length uint64
+	// Range of the number of words, when MaxLength is set the length is drawn from
+	// [MinLength, MaxLength] on every generation and Length is ignored.
+	MinLength uint64
+	MaxLength uint64
+	// Relative probability of each length in the range, starting from MinLength. If it's empty
+	// the length is drawn uniformly.
+	LengthWeights []float64
+	// Letter case applied to the words, random cases add entropy.
+	Case Case
+	// Random characters added to the passphrase, useful to meet composition rules.
+	Padding []Padding
+	// Sample words without replacement, so none of them is repeated. Sources must report the number
+	// of words they generate (the ones in this package do, except PGPWords).
+	Unique bool
+	// Word length limits, words out of them are filtered from the list. When using NoList
+	// they are the length range of the words generated (3 to 12 by default).
+	MinWordLength uint64
+	MaxWordLength uint64
+	// Maximum number of characters of the passphrase, including separators and padding.
+	//
+	// Words are chosen so that every passphrase that fits is equally likely. It requires a word
+	// or syllable list and cannot be combined with Unique.
+	MaxChars uint64
+	// Parts of speech of the words, when it's set the passphrase is a sentence that follows the
+	// template (see ParseTemplate). It takes precedence over List and determines the length, words
+	// cannot be included.
+	Template []PartOfSpeech
 }
 
 type list func(p *Passphrase, length int)
 
+// WordSource is the interface that wraps the methods used to generate the words of a passphrase
+// when a list is not enough.
+type WordSource interface {
+	// Word returns a random word for the i-th position of the passphrase.
+	Word(i int) []byte
+	// Entropy returns the entropy in bits of the word in the i-th position.
+	Entropy(i int) float64
+}
+
+// letterCounter is implemented by sources that know the expected number of letters of their words,
+// required to compute the entropy added by RandomLetterCase.
+type letterCounter interface {
+	meanLetters(i int) float64
+}
+
+// wordCounter is implemented by sources that know how many different words they generate, required
+// to check that there are enough of them when Unique is set.
+type wordCounter interface {
+	wordCount() float64
+}
+
+// validator is implemented by sources whose parameters must be validated before generating words.
+type validator interface {
+	validate() error
+}
+
+// optionsValidator is implemented by sources that don't support some of the passphrase options.
+type optionsValidator interface {
+	validateOptions(p *Passphrase) error
+}
+
 // NewPassphrase returns a random passphrase.
 func NewPassphrase(length uint64, l list) ([]byte, error) {
 	p := &Passphrase{
@@ -69,16 +187,44 @@ func (p *Passphrase) generate() ([]byte, error) {
 	if p.Separator == "" {
 		p.Separator = " "
 	}
-	if p.List == nil {
+	if p.List == nil && p.Source == nil {
 		p.List = NoList
 	}
 
+	wordsLength := p.Length
+	switch {
+	case len(p.Template) != 0:
+		wordsLength = uint64(len(p.Template))
+	case p.MaxLength != 0:
+		wordsLength = randLength(p.MinLength, p.MaxLength, p.LengthWeights)
+	}
+
 	// Initialize secret slice
-	p.words = make([][]byte, p.Length)
-	length := int(p.Length) - len(p.Include)
+	p.words = make([][]byte, wordsLength)
+	length := int(wordsLength) - len(p.Include)
+
+	if err := p.checkSources(length); err != nil {
+		return nil, err
+	}
 
 	// Generate the passphrase with the list specified
-	p.List(p, length)
+	p.seps = nil
+	switch {
+	case p.MaxChars != 0:
+		if err := p.fitWords(wordsLength); err != nil {
+			return nil, err
+		}
+	case p.Source != nil:
+		for i := 0; i < length; i++ {
+			p.words[i] = p.randWord(nil, i)
+		}
+	case len(p.Template) != 0:
+		for i := 0; i < length; i++ {
+			p.words[i] = p.randWord(p.slotSource(i), i)
+		}
+	default:
+		p.List(p, length)
+	}
 
 	// Include and exclude words
 	if len(p.Include) != 0 {
@@ -88,24 +234,52 @@ func (p *Passphrase) generate() ([]byte, error) {
 		p.excludeWords()
 	}
 
-	passphrase := bytes.Join(p.words, []byte(p.Separator))
+	// Copy the words so the lists aren't modified when changing the case or wiping them
+	for i, word := range p.words {
+		p.words[i] = bytes.Clone(word)
+	}
+	p.applyCase()
+
+	tokens, prefix, suffix := p.pad()
+	passphrase := make([]byte, 0, len(prefix)+len(suffix))
+	passphrase = append(passphrase, prefix...)
+	passphrase = append(passphrase, p.join(tokens)...)
+	passphrase = append(passphrase, suffix...)
 	// Wipe sensitive data
-	for i := range p.words {
-		for j := range p.words[i] {
-			p.words[i][j] = 0
+	for _, words := range [][][]byte{p.words, tokens, {prefix, suffix}} {
+		for i := range words {
+			for j := range words[i] {
+				words[i][j] = 0
+			}
 		}
 	}
 	// Keep buf alive so preceding loop is not optimized out
 	runtime.KeepAlive(p.words)
+	runtime.KeepAlive(tokens)
 	return passphrase, nil
 }
 
 func (p *Passphrase) validateParams() error {
-	if p.Length < 1 {
+	if err := validateLengthRange(p.MinLength, p.MaxLength, p.LengthWeights); err != nil {
+		return err
+	}
+
+	if err := p.validateTemplate(); err != nil {
+		return err
+	}
+
+	minLength := p.Length
+	switch {
+	case len(p.Template) != 0:
+		minLength = uint64(len(p.Template))
+	case p.MaxLength != 0:
+		minLength = p.MinLength
+	}
+	if minLength < 1 {
 		return errors.New("passphrase length must be equal to or higher than 1")
 	}
 
-	if len(p.Include) > int(p.Length) {
+	if len(p.Include) > int(minLength) {
 		return errors.New("number of words to include exceed the password length")
 	}
 
@@ -114,6 +288,46 @@ func (p *Passphrase) validateParams() error {
 		return fmt.Errorf("separator %q contains invalid characters", p.Separator)
 	}
 
+	seps := make(map[string]struct{}, len(p.Separators))
+	for _, sep := range p.Separators {
+		if len(sep) != utf8.RuneCountInString(sep) {
+			return fmt.Errorf("separator %q contains invalid characters", sep)
+		}
+		if _, ok := seps[sep]; ok {
+			return fmt.Errorf("separator %q is repeated", sep)
+		}
+		seps[sep] = struct{}{}
+	}
+
+	if p.Case > RandomLetterCase {
+		return fmt.Errorf("invalid case: %d", p.Case)
+	}
+
+	if p.MaxWordLength != 0 && p.MinWordLength > p.MaxWordLength {
+		return errors.New("minimum word length is higher than the maximum word length")
+	}
+
+	if err := p.validateMaxChars(); err != nil {
+		return err
+	}
+
+	if v, ok := p.Source.(validator); ok {
+		if err := v.validate(); err != nil {
+			return err
+		}
+	}
+	if v, ok := p.Source.(optionsValidator); ok {
+		if err := v.validateOptions(p); err != nil {
+			return err
+		}
+	}
+
+	for _, pad := range p.Padding {
+		if err := pad.validate(minLength); err != nil {
+			return err
+		}
+	}
+
 	for _, incl := range p.Include {
 		// Look for words contaning 2/3 bytes characters
 		if len(incl) != utf8.RuneCountInString(incl) {
@@ -122,7 +336,7 @@ func (p *Passphrase) validateParams() error {
 
 		// Check for equality between included and excluded words
 		for _, excl := range p.Exclude {
-			if incl == excl {
+			if strings.EqualFold(incl, excl) {
 				return fmt.Errorf("word %q cannot be included and excluded", excl)
 			}
 		}
@@ -131,11 +345,97 @@ func (p *Passphrase) validateParams() error {
 	return nil
 }
 
+// validate checks that the padding can be added to a passphrase of at least minLength words.
+func (pad Padding) validate(minLength uint64) error {
+	if len(pad.Level) == 0 {
+		return errors.New("padding level is empty")
+	}
+
+	if len(pad.Level) != utf8.RuneCountInString(string(pad.Level)) {
+		return fmt.Errorf("padding level %q contains invalid characters", pad.Level)
+	}
+
+	if pad.Count < 1 {
+		return errors.New("padding count must be equal to or higher than 1")
+	}
+
+	switch pad.Position {
+	case PadBefore, PadAfter, PadWord:
+	case PadBetween:
+		if minLength < 2 {
+			return errors.New("padding between words requires at least two words")
+		}
+	default:
+		return fmt.Errorf("invalid padding position: %d", pad.Position)
+	}
+
+	return nil
+}
+
+// pad returns the words with the padding groups inserted, and the characters that go
+// before and after the phrase.
+func (p *Passphrase) pad() (tokens [][]byte, prefix, suffix []byte) {
+	tokens = make([][]byte, len(p.words), len(p.words)+len(p.Padding))
+	copy(tokens, p.words)
+
+	// Append characters to words first so the indices aren't shifted by the groups inserted
+	for _, pad := range p.Padding {
+		if pad.Position == PadWord {
+			i := randInt(len(p.words))
+			tokens[i] = append(tokens[i], pad.chars()...)
+		}
+	}
+
+	for _, pad := range p.Padding {
+		switch pad.Position {
+		case PadBefore:
+			prefix = append(prefix, pad.chars()...)
+		case PadAfter:
+			suffix = append(suffix, pad.chars()...)
+		case PadBetween:
+			i := randInt(len(tokens)-1) + 1
+			tokens = slices.Insert(tokens, int(i), pad.chars())
+		}
+	}
+
+	return tokens, prefix, suffix
+}
+
+// chars returns Count random characters from the padding level.
+func (pad Padding) chars() []byte {
+	chars := make([]byte, pad.Count)
+	for i := range chars {
+		chars[i] = pad.Level[randInt(len(pad.Level))]
+	}
+	return chars
+}
+
+// join concatenates the tokens placing a separator between them.
+func (p *Passphrase) join(tokens [][]byte) []byte {
+	if len(p.Separators) == 0 {
+		return bytes.Join(tokens, []byte(p.Separator))
+	}
+
+	var passphrase []byte
+	for i, token := range tokens {
+		if i > 0 {
+			if p.seps != nil {
+				passphrase = append(passphrase, p.seps[i-1]...)
+			} else {
+				passphrase = append(passphrase, p.Separators[randInt(len(p.Separators))]...)
+			}
+		}
+		passphrase = append(passphrase, token...)
+	}
+
+	return passphrase
+}
+
 // includeWords randomly inserts included words in the passphrase.
 func (p *Passphrase) includeWords() {
 	// Add included words at the end of the secret
 	for i, word := range p.Include {
-		p.words[int(p.Length)-i-1] = []byte(word)
+		p.words[len(p.words)-i-1] = []byte(word)
 	}
 
 	// Shuffle the secret so included words aren't always at the end
@@ -146,20 +446,15 @@ func (p *Passphrase) includeWords() {
 }
 
 // excludeWords checks if any excluded word is within the secret and (if true) replace it with another random word.
+//
+// Words are compared ignoring the case, as it's applied after the exclusion.
 func (p *Passphrase) excludeWords() {
 	for i, word := range p.words {
 		for _, excl := range p.Exclude {
-			if bytes.Compare(word, []byte(excl)) == 0 {
-				switch getFuncName(p.List) {
-				case noListType:
-					p.words[i] = genRandWord()
-
-				case wordListType:
-					p.words[i] = wordList[randInt(len(wordList))]
-
-				case syllableListType:
-					p.words[i] = syllableList[randInt(len(syllableList))]
-				}
+			if bytes.EqualFold(word, []byte(excl)) {
+				// Unset the word so it's not considered when looking for repetitions
+				p.words[i] = nil
+				p.words[i] = p.randWord(p.slotSource(i), i)
 
 				// Use recursion to repeat the process until there is no excluded word
 				p.excludeWords()
@@ -168,60 +463,449 @@ func (p *Passphrase) excludeWords() {
 	}
 }
 
+// listSource returns the list used filtered by the word length limits, or nil if no list is used.
+func (p *Passphrase) listSource() [][]byte {
+	if p.Source != nil {
+		return nil
+	}
+
+	switch getFuncName(p.List) {
+	case wordListType:
+		return p.filterWords(wordList)
+	case syllableListType:
+		return p.filterWords(syllableList)
+	case mnemonicListType:
+		return p.filterWords(mnemonicList)
+	default:
+		return nil
+	}
+}
+
+// slotSource returns the list the i-th word is taken from filtered by the word length limits,
+// or nil if no list is used.
+func (p *Passphrase) slotSource(i int) [][]byte {
+	if len(p.Template) != 0 {
+		return p.filterWords(p.Template[i].list())
+	}
+	return p.listSource()
+}
+
+// slotName returns the name of the list the i-th word is taken from.
+func (p *Passphrase) slotName(i int) string {
+	if len(p.Template) != 0 {
+		return p.Template[i].String()
+	}
+	return getFuncName(p.List)
+}
+
+// checkSources checks that there are enough words to generate length words from the lists used.
+func (p *Passphrase) checkSources(length int) error {
+	if p.Unique && len(p.Template) == 0 {
+		// Included and excluded words may not be part of the words generated, discount them anyway
+		needed := float64(length + len(p.Include) + len(p.Exclude))
+		switch {
+		case p.Source != nil:
+			counter, ok := p.Source.(wordCounter)
+			if !ok {
+				return errors.New("the word source doesn't support unique words")
+			}
+			if counter.wordCount() < needed {
+				return errors.New("not enough words in the source to generate unique words")
+			}
+		case getFuncName(p.List) == noListType:
+			if p.noListWordCount() < needed {
+				return errors.New("not enough words of the length limits to generate unique words")
+			}
+		}
+	}
+
+	needed := make(map[string]int)
+	for i := 0; i < length; i++ {
+		source := p.slotSource(i)
+		if source == nil {
+			return nil
+		}
+		if len(source) == 0 {
+			return errors.New("no words meet the length limits")
+		}
+
+		name := p.slotName(i)
+		needed[name]++
+		if p.Unique && p.available(source) < needed[name] {
+			return errors.New("not enough words in the list to generate unique words")
+		}
+	}
+
+	return nil
+}
+
+// filterWords returns the words of the list that meet the length limits.
+func (p *Passphrase) filterWords(list [][]byte) [][]byte {
+	if p.MinWordLength == 0 && p.MaxWordLength == 0 {
+		return list
+	}
+
+	source := make([][]byte, 0, len(list))
+	for _, word := range list {
+		if uint64(len(word)) < p.MinWordLength {
+			continue
+		}
+		if p.MaxWordLength != 0 && uint64(len(word)) > p.MaxWordLength {
+			continue
+		}
+		source = append(source, word)
+	}
+
+	return source
+}
+
+// available returns the number of words in source that are neither included nor excluded.
+func (p *Passphrase) available(source [][]byte) int {
+	n := len(source)
+	for _, word := range source {
+		if slices.ContainsFunc(p.Include, equalFold(word)) || slices.ContainsFunc(p.Exclude, equalFold(word)) {
+			n--
+		}
+	}
+	return n
+}
+
+// equalFold returns a function that reports whether a string is equal to word ignoring the case.
+func equalFold(word []byte) func(string) bool {
+	return func(s string) bool {
+		return bytes.EqualFold(word, []byte(s))
+	}
+}
+
+// randWord returns a random word for the i-th position from the Source, from source or a generated
+// one if both are nil.
+//
+// If Unique is set, the word won't be equal to any of the words in the passphrase.
+func (p *Passphrase) randWord(source [][]byte, i int) []byte {
+	for {
+		var word []byte
+		switch {
+		case p.Source != nil:
+			word = p.Source.Word(i)
+		case source == nil:
+			word = genRandWord(p.noListLengths())
+		default:
+			word = source[randInt(len(source))]
+		}
+
+		if !p.Unique || !p.used(word) {
+			return word
+		}
+	}
+}
+
+// used returns whether the word is already part of the passphrase.
+func (p *Passphrase) used(word []byte) bool {
+	for _, w := range p.words {
+		if w != nil && bytes.EqualFold(w, word) {
+			return true
+		}
+	}
+	return slices.ContainsFunc(p.Include, equalFold(word))
+}
+
+// noListLengths returns the length range of the words generated without a list.
+func (p *Passphrase) noListLengths() (min, max int) {
+	min, max = 3, 12
+	if p.MinWordLength != 0 {
+		min = int(p.MinWordLength)
+	}
+	if p.MaxWordLength != 0 {
+		max = int(p.MaxWordLength)
+	}
+
+	if min > max {
+		// Only one of the limits was set
+		if p.MaxWordLength == 0 {
+			max = min
+		} else {
+			min = max
+		}
+	}
+
+	return min, max
+}
+
+// noListWordCount returns the number of different words that can be generated without a list.
+func (p *Passphrase) noListWordCount() float64 {
+	min, max := p.noListLengths()
+	var count float64
+	for length := min; length <= max; length++ {
+		// Every letter is either a vowel or a consonant
+		count += math.Pow(float64(len(vowels)+len(consonants)), float64(length))
+	}
+	return count
+}
+
 // Entropy returns the passphrase entropy in bits.
 //
 // If the list used is "NoList" the secret must be already generated.
+//
+// If a length range is used, it's the entropy of the length plus the expected entropy of the
+// passphrase given its length, weighted by LengthWeights.
 func (p *Passphrase) Entropy() float64 {
-	var poolLength int
+	if len(p.Template) != 0 {
+		return p.entropy(uint64(len(p.Template)))
+	}
+	if p.Source == nil && getFuncName(p.List) == noListType {
+		return p.noListEntropy()
+	}
 
-	switch getFuncName(p.List) {
-	case noListType:
-		if len(p.words) == 0 {
-			return 0
+	if p.MaxLength != 0 {
+		return rangeEntropy(p.MinLength, p.MaxLength, p.LengthWeights, p.entropy)
+	}
+	return p.entropy(p.Length)
+}
+
+// noListEntropy returns the entropy of the passphrase generated without a list.
+func (p *Passphrase) noListEntropy() float64 {
+	if len(p.words) == 0 {
+		return 0
+	}
+
+	// Count the generated words of each length, their content may have been wiped already so
+	// take out the lengths of the included words, which don't add entropy
+	counts := make(map[int]int)
+	for _, word := range p.words {
+		counts[len(word)]++
+	}
+	included := make(map[int]int)
+	letters := 0
+	for _, incl := range p.Include {
+		counts[len(incl)]--
+		included[len(incl)]++
+		letters += letterCount([]byte(incl))
+	}
+
+	alphabet := float64(len(vowels) + len(consonants))
+	var entropy float64
+	for length, n := range counts {
+		// Generated words are composed only of letters
+		letters += length * n
+		if !p.Unique {
+			entropy += float64(length*n) * math.Log2(alphabet)
+			continue
 		}
 
-		words := bytes.Join(p.words, []byte(""))
-		// Take out the separators from the secret length
-		secretLength := len(words) - (len(p.Separator) * int(p.Length))
-		return math.Log2(math.Pow(float64(len(vowels)+len(consonants)), float64(secretLength)))
-	case wordListType:
-		poolLength = len(wordList)
-	case syllableListType:
-		poolLength = len(syllableList)
+		// Words are sampled without replacement and cannot be any of the included ones
+		pool := math.Pow(alphabet, float64(length)) - float64(included[length])
+		for i := 0; i < n; i++ {
+			entropy += math.Log2(pool - float64(i))
+		}
+	}
+
+	switch p.Case {
+	case RandomWordCase:
+		entropy += float64(len(p.words))
+	case RandomLetterCase:
+		entropy += float64(letters)
 	}
 
+	length := uint64(len(p.words))
+	return entropy + p.paddingEntropy(length) + p.separatorsEntropy(length)
+}
+
+// entropy returns the entropy of a passphrase of the given length.
+//
+// If Unique is set, the number of possible passphrases is the falling factorial of the list
+// length instead of its power.
+func (p *Passphrase) entropy(length uint64) float64 {
+	if p.MaxChars != 0 {
+		return p.fitEntropy(length)
+	}
+	if p.Source != nil {
+		return p.sourceEntropy(length)
+	}
+	if len(p.Template) != 0 {
+		return p.templateEntropy()
+	}
+
+	var poolLength int
+
+	source := p.listSource()
+	poolLength = len(source)
+
 	poolLength += len(p.Include) - len(p.Exclude)
 
 	// Separators aren't included in the secret length
-	return math.Log2(math.Pow(float64(poolLength), float64(p.Length)))
+	var entropy float64
+	if p.Unique {
+		for i := 0; i < int(length); i++ {
+			entropy += math.Log2(float64(poolLength - i))
+		}
+	} else {
+		entropy = math.Log2(math.Pow(float64(poolLength), float64(length)))
+	}
+	return entropy + p.caseEntropy(source, length) + p.paddingEntropy(length) + p.separatorsEntropy(length)
+}
+
+// sourceEntropy returns the entropy of a passphrase of the given length whose words are generated by
+// the Source.
+func (p *Passphrase) sourceEntropy(length uint64) float64 {
+	var entropy, letters float64
+	generated := int(length) - len(p.Include)
+	counter, ok := p.Source.(letterCounter)
+	for i := 0; i < generated; i++ {
+		entropy += p.Source.Entropy(i)
+		if ok {
+			letters += counter.meanLetters(i)
+		}
+	}
+
+	switch p.Case {
+	case RandomWordCase:
+		entropy += float64(length)
+	case RandomLetterCase:
+		// Sources that do not report their letters add no entropy to stay on the safe side
+		entropy += letters
+		for _, incl := range p.Include {
+			entropy += float64(letterCount([]byte(incl)))
+		}
+	}
+
+	return entropy + p.paddingEntropy(length) + p.separatorsEntropy(length)
+}
+
+// caseEntropy returns the entropy added by the random cases to a passphrase of the given length
+// whose words are taken from source.
+func (p *Passphrase) caseEntropy(source [][]byte, length uint64) float64 {
+	switch p.Case {
+	case RandomWordCase:
+		// One bit per word, lowercase or uppercase
+		return float64(length)
+	case RandomLetterCase:
+		// One bit per letter, the entropy of a random word with random letter cases
+		// is log2(len(source)) + mean(letters)
+		var letters, included int
+		for _, word := range source {
+			letters += letterCount(word)
+		}
+		for _, incl := range p.Include {
+			included += letterCount([]byte(incl))
+		}
+		mean := float64(letters) / float64(len(source))
+		return mean*float64(int(length)-len(p.Include)) + float64(included)
+	default:
+		return 0
+	}
+}
+
+// paddingEntropy returns the entropy added by the padding to a passphrase of the given length,
+// that is, the entropy of the characters plus the one of their position.
+func (p *Passphrase) paddingEntropy(length uint64) float64 {
+	var entropy float64
+	gaps := float64(length) - 1
+	for _, pad := range p.Padding {
+		entropy += float64(pad.Count) * levelEntropy(pad.Level)
+
+		switch pad.Position {
+		case PadBetween:
+			entropy += math.Log2(gaps)
+			// The group inserted adds a new gap
+			gaps++
+		case PadWord:
+			entropy += math.Log2(float64(length))
+		}
+	}
+
+	return entropy
+}
+
+// separatorsEntropy returns the entropy added by random separators to a passphrase of the given length.
+func (p *Passphrase) separatorsEntropy(length uint64) float64 {
+	if len(p.Separators) == 0 {
+		return 0
+	}
+
+	// Groups inserted between words add a gap each
+	gaps := float64(length) - 1
+	for _, pad := range p.Padding {
+		if pad.Position == PadBetween {
+			gaps++
+		}
+	}
+
+	return gaps * math.Log2(float64(len(p.Separators)))
+}
+
+// applyCase changes the letter case of the words.
+func (p *Passphrase) applyCase() {
+	for i, word := range p.words {
+		switch p.Case {
+		case LowerCase:
+			toLower(word)
+		case UpperCase:
+			toUpper(word)
+		case TitleCase:
+			toTitle(word)
+		case CamelCase:
+			if i == 0 {
+				toLower(word)
+			} else {
+				toTitle(word)
+			}
+		case RandomWordCase:
+			if randInt(2) == 0 {
+				toLower(word)
+			} else {
+				toUpper(word)
+			}
+		case RandomLetterCase:
+			for j, c := range word {
+				if randInt(2) == 0 {
+					word[j] = lower(c)
+				} else {
+					word[j] = upper(c)
+				}
+			}
+		}
+	}
+}
+
+// SeparatorsFromLevel returns the characters of the level as a set of separators.
+func SeparatorsFromLevel(lvl Level) []string {
+	seps := make([]string, 0, len(lvl))
+	for i := 0; i < len(lvl); i++ {
+		if sep := lvl[i : i+1]; !slices.Contains(seps, string(sep)) {
+			seps = append(seps, string(sep))
+		}
+	}
+	return seps
 }
 
 // NoList generates a random passphrase without using a list, making the potential attacker work harder.
 func NoList(p *Passphrase, length int) {
 	for i := 0; i < length; i++ {
-		p.words[i] = genRandWord()
+		p.words[i] = p.randWord(nil, i)
 	}
 }
 
 // WordList generates a passphrase using a wordlist (18,325 long).
 func WordList(p *Passphrase, length int) {
+	source := p.filterWords(wordList)
 	for i := 0; i < length; i++ {
-		p.words[i] = wordList[randInt(len(wordList))]
+		p.words[i] = p.randWord(source, i)
 	}
 }
 
 // SyllableList generates a passphrase using a syllable list (10,129 long).
 func SyllableList(p *Passphrase, length int) {
+	source := p.filterWords(syllableList)
 	for i := 0; i < length; i++ {
-		p.words[i] = syllableList[randInt(len(syllableList))]
+		p.words[i] = p.randWord(source, i)
 	}
 }
 
 // genRandWord returns a random word without using any list or dictionary.
-func genRandWord() []byte {
+func genRandWord(min, max int) []byte {
 	var buf bytes.Buffer
-	// Words length are randomly selected between 3 and 12 letters.
-	wordLength := int(randInt(10)) + 3
+	// Words length are randomly selected between min and max letters.
+	wordLength := int(randInt(max-min+1)) + min
 	buf.Grow(wordLength)
 
 	for i := 0; i < wordLength; i++ {
@@ -3,6 +3,7 @@ package atoll
 import (
 	"bytes"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -123,6 +124,12 @@ func TestExcludeWords(t *testing.T) {
 			Exclude:   []string{"alt", "flux"},
 			List:      SyllableList,
 		},
+		"Case insensitive": {
+			words:     [][]byte{[]byte("about"), []byte("abysmal"), []byte("accurate")},
+			Separator: " ",
+			Exclude:   []string{"ABOUT", "Abysmal"},
+			List:      WordList,
+		},
 	}
 
 	for k, tc := range cases {
@@ -131,7 +138,7 @@ func TestExcludeWords(t *testing.T) {
 
 			for _, exc := range tc.Exclude {
 				for _, word := range tc.words {
-					if exc == string(word) {
+					if strings.EqualFold(exc, string(word)) {
 						t.Errorf("Found undesired word %q", exc)
 					}
 				}
@@ -175,18 +182,45 @@ func TestPassphraseEntropy(t *testing.T) {
 
 			// NoList entropy changes everytime as it generates random words
 			if getFuncName(tc.list) == "NoList" {
-				secretLength := len(bytes.Join(p.words, []byte(""))) - (len(p.Separator) * int(p.Length))
-				tc.expected = math.Log2(math.Pow(float64(26), float64(secretLength)))
+				// The included word doesn't add entropy
+				secretLength := len(bytes.Join(p.words, nil)) - len("atoll")
+				tc.expected = float64(secretLength) * math.Log2(26)
 			}
 
 			got := p.Entropy()
-			if got != tc.expected {
+			if math.Abs(got-tc.expected) > 1e-9 {
 				t.Errorf("Expected %f, got %f", tc.expected, got)
 			}
 		})
 	}
 }
 
+func TestPassphraseNoListEntropy(t *testing.T) {
+	cases := []struct {
+		p        *Passphrase
+		expected float64
+	}{
+		{
+			p:        &Passphrase{Length: 6, List: NoList, Separator: "-", MaxWordLength: 2, MinWordLength: 2},
+			expected: 12 * math.Log2(26),
+		},
+		{
+			// The separator is not part of the words
+			p:        &Passphrase{Length: 6, List: NoList, Separator: "---", MaxWordLength: 1, Unique: true},
+			expected: math.Log2(26 * 25 * 24 * 23 * 22 * 21),
+		},
+	}
+
+	for _, tc := range cases {
+		if _, err := tc.p.Generate(); err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		if got := tc.p.Entropy(); math.Abs(got-tc.expected) > 1e-9 {
+			t.Errorf("Expected %f, got %f", tc.expected, got)
+		}
+	}
+}
+
 func TestPassphraseEntropyNoSecret(t *testing.T) {
 	p := &Passphrase{
 		Length:    7,
@@ -200,3 +234,461 @@ func TestPassphraseEntropyNoSecret(t *testing.T) {
 		t.Errorf("Expected %f, got %f", expected, got)
 	}
 }
+
+func TestPassphraseLengthRange(t *testing.T) {
+	p := &Passphrase{
+		MinLength: 3,
+		MaxLength: 6,
+		Separator: "-",
+		List:      WordList,
+		Include:   []string{"atoll"},
+	}
+
+	for i := 0; i < 100; i++ {
+		passphrase, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		words := bytes.Split(passphrase, []byte(p.Separator))
+		if len(words) < int(p.MinLength) || len(words) > int(p.MaxLength) {
+			t.Errorf("Passphrase length %d is out of range", len(words))
+		}
+	}
+
+	invalid := &Passphrase{MinLength: 1, MaxLength: 4, Include: []string{"a", "b"}}
+	if _, err := invalid.Generate(); err == nil {
+		t.Error("Expected included words to exceed the minimum length")
+	}
+}
+
+func TestPassphraseLengthRangeEntropy(t *testing.T) {
+	p := &Passphrase{
+		MinLength: 4,
+		MaxLength: 5,
+		List:      SyllableList,
+	}
+
+	bits := math.Log2(float64(len(syllableList)))
+	expected := 1 + 4.5*bits
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected entropy %f, got %f", expected, got)
+	}
+
+	if got := math.Log2(Keyspace(p)); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected keyspace to be 2^%f, got 2^%f", expected, got)
+	}
+}
+
+func TestPassphraseCase(t *testing.T) {
+	cases := map[Case]func(words [][]byte) bool{
+		LowerCase: func(words [][]byte) bool {
+			return bytes.Equal(bytes.Join(words, nil), bytes.ToLower(bytes.Join(words, nil)))
+		},
+		UpperCase: func(words [][]byte) bool {
+			return bytes.Equal(bytes.Join(words, nil), bytes.ToUpper(bytes.Join(words, nil)))
+		},
+		TitleCase: func(words [][]byte) bool {
+			for _, w := range words {
+				if w[0] != upper(w[0]) || !bytes.Equal(w[1:], bytes.ToLower(w[1:])) {
+					return false
+				}
+			}
+			return true
+		},
+		CamelCase: func(words [][]byte) bool {
+			if !bytes.Equal(words[0], bytes.ToLower(words[0])) {
+				return false
+			}
+			for _, w := range words[1:] {
+				if w[0] != upper(w[0]) || !bytes.Equal(w[1:], bytes.ToLower(w[1:])) {
+					return false
+				}
+			}
+			return true
+		},
+		RandomWordCase: func(words [][]byte) bool {
+			for _, w := range words {
+				if !bytes.Equal(w, bytes.ToLower(w)) && !bytes.Equal(w, bytes.ToUpper(w)) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+
+	for c, valid := range cases {
+		p := &Passphrase{
+			Length:    6,
+			Separator: "-",
+			List:      WordList,
+			Include:   []string{"AtoLL"},
+			Exclude:   []string{"Apple"},
+			Case:      c,
+		}
+
+		passphrase, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		words := bytes.Split(passphrase, []byte(p.Separator))
+		if !valid(words) {
+			t.Errorf("Case %d was not applied: %q", c, passphrase)
+		}
+
+		if !bytes.Contains(bytes.ToLower(passphrase), []byte("atoll")) {
+			t.Errorf("Expected %q to be included", "atoll")
+		}
+	}
+
+	if _, err := (&Passphrase{Length: 3, Case: RandomLetterCase + 1}).Generate(); err == nil {
+		t.Error("Expected invalid case error, got nil")
+	}
+	if _, err := (&Passphrase{Length: 3, Include: []string{"Go"}, Exclude: []string{"GO"}}).Generate(); err == nil {
+		t.Error("Expected included and excluded word error, got nil")
+	}
+}
+
+func TestPassphraseListsUnmodified(t *testing.T) {
+	first := bytes.Clone(syllableList[0])
+	for i := 0; i < 200; i++ {
+		p := &Passphrase{Length: 50, List: SyllableList, Case: UpperCase}
+		if _, err := p.Generate(); err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+	}
+
+	for _, word := range syllableList {
+		if len(word) == 0 || word[0] == 0 || !bytes.Equal(word, bytes.ToLower(word)) {
+			t.Fatalf("The syllable list was modified: %q", word)
+		}
+	}
+	if !bytes.Equal(first, syllableList[0]) {
+		t.Errorf("Expected %q, got %q", first, syllableList[0])
+	}
+}
+
+func TestPassphraseCaseEntropy(t *testing.T) {
+	base := 4 * math.Log2(float64(len(wordList)))
+
+	var letters int
+	for _, word := range wordList {
+		letters += letterCount(word)
+	}
+	mean := float64(letters) / float64(len(wordList))
+
+	cases := map[Case]float64{
+		KeepCase:         base,
+		UpperCase:        base,
+		CamelCase:        base,
+		RandomWordCase:   base + 4,
+		RandomLetterCase: base + 4*mean,
+	}
+
+	for c, expected := range cases {
+		p := &Passphrase{Length: 4, List: WordList, Case: c}
+		if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+			t.Errorf("Case %d: expected %f, got %f", c, expected, got)
+		}
+	}
+
+	p := &Passphrase{Length: 4, List: NoList, Case: RandomLetterCase}
+	if _, err := p.Generate(); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+	secretLength := len(bytes.Join(p.words, nil))
+	expected := float64(secretLength) * (math.Log2(26) + 1)
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestPassphrasePadding(t *testing.T) {
+	cases := map[string]struct {
+		padding []Padding
+		valid   func(passphrase []byte) bool
+	}{
+		"Before": {
+			padding: []Padding{{Level: Digit, Count: 3, Position: PadBefore}},
+			valid: func(passphrase []byte) bool {
+				return bytes.IndexAny(passphrase, string(Digit)) == 0 &&
+					bytes.LastIndexAny(passphrase, string(Digit)) == 2
+			},
+		},
+		"After": {
+			padding: []Padding{{Level: Level("!?"), Count: 2, Position: PadAfter}},
+			valid: func(passphrase []byte) bool {
+				return bytes.IndexAny(passphrase, "!?") == len(passphrase)-2
+			},
+		},
+		"Between": {
+			padding: []Padding{{Level: Digit, Count: 2, Position: PadBetween}},
+			valid: func(passphrase []byte) bool {
+				words := bytes.Split(passphrase, []byte("-"))
+				if len(words) != 5 {
+					return false
+				}
+				for i, w := range words {
+					if bytes.ContainsAny(w, string(Digit)) {
+						return i != 0 && i != len(words)-1 && len(w) == 2
+					}
+				}
+				return false
+			},
+		},
+		"Word": {
+			padding: []Padding{{Level: Digit, Count: 1, Position: PadWord}},
+			valid: func(passphrase []byte) bool {
+				words := bytes.Split(passphrase, []byte("-"))
+				for _, w := range words {
+					if bytes.ContainsAny(w, string(Digit)) {
+						return bytes.IndexAny(w, string(Digit)) == len(w)-1
+					}
+				}
+				return false
+			},
+		},
+	}
+
+	for k, tc := range cases {
+		t.Run(k, func(t *testing.T) {
+			p := &Passphrase{
+				Length:    4,
+				Separator: "-",
+				List:      WordList,
+				Padding:   tc.padding,
+			}
+
+			for i := 0; i < 20; i++ {
+				passphrase, err := p.Generate()
+				if err != nil {
+					t.Fatalf("Generate() failed: %v", err)
+				}
+
+				if !tc.valid(passphrase) {
+					t.Errorf("Invalid padding: %q", passphrase)
+				}
+			}
+		})
+	}
+}
+
+func TestInvalidPadding(t *testing.T) {
+	cases := map[string]Padding{
+		"empty level":      {Count: 1, Level: Level("")},
+		"invalid level":    {Count: 1, Level: Level("¡")},
+		"zero count":       {Count: 0, Level: Digit},
+		"invalid position": {Count: 1, Level: Digit, Position: PadWord + 1},
+		"not enough words": {Count: 1, Level: Digit, Position: PadBetween},
+	}
+
+	for k, tc := range cases {
+		p := &Passphrase{Length: 1, Padding: []Padding{tc}}
+		if _, err := p.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
+
+func TestPassphrasePaddingEntropy(t *testing.T) {
+	p := &Passphrase{
+		Length: 5,
+		List:   WordList,
+		Padding: []Padding{
+			{Level: Digit, Count: 2, Position: PadBefore},
+			{Level: Level("!?."), Count: 1, Position: PadBetween},
+			{Level: Digit, Count: 1, Position: PadBetween},
+			{Level: Level("aab"), Count: 1, Position: PadWord},
+		},
+	}
+
+	expected := 5*math.Log2(float64(len(wordList))) +
+		2*math.Log2(10) +
+		math.Log2(3) + math.Log2(4) +
+		math.Log2(10) + math.Log2(5) +
+		-(2.0/3*math.Log2(2.0/3) + 1.0/3*math.Log2(1.0/3)) + math.Log2(5)
+
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestPassphraseSeparators(t *testing.T) {
+	p := &Passphrase{
+		Length:     6,
+		List:       WordList,
+		Separators: SeparatorsFromLevel(Digit + "-_.!"),
+	}
+
+	for i := 0; i < 20; i++ {
+		passphrase, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		gaps := 0
+		for _, c := range passphrase {
+			if !strings.Contains(string(Lower), string(c)) {
+				gaps++
+			}
+		}
+		if gaps != int(p.Length)-1 {
+			t.Errorf("Expected %d separators, got %d: %q", p.Length-1, gaps, passphrase)
+		}
+	}
+
+	invalid := map[string][]string{
+		"repeated":      {"-", "_", "-"},
+		"invalid chars": {"-", "¿"},
+	}
+	for k, seps := range invalid {
+		if _, err := (&Passphrase{Length: 3, Separators: seps}).Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
+
+func TestSeparatorsFromLevel(t *testing.T) {
+	got := SeparatorsFromLevel(Level("-_-."))
+	expected := []string{"-", "_", "."}
+
+	if strings.Join(got, "") != strings.Join(expected, "") {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestPassphraseSeparatorsEntropy(t *testing.T) {
+	p := &Passphrase{
+		Length:     5,
+		List:       WordList,
+		Separators: []string{"-", "_", ".", "!"},
+		Padding:    []Padding{{Level: Digit, Count: 1, Position: PadBetween}},
+	}
+
+	expected := 5*math.Log2(float64(len(wordList))) + math.Log2(10) + math.Log2(4) + 5*2
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestPassphraseUnique(t *testing.T) {
+	p := &Passphrase{
+		Length:        20,
+		Separator:     " ",
+		List:          SyllableList,
+		Include:       []string{"ab"},
+		Exclude:       []string{"ac"},
+		Unique:        true,
+		MaxWordLength: 2,
+	}
+
+	source := p.listSource()
+	if len(source) < 22 || len(source) > 200 {
+		t.Fatalf("Unexpected number of two-letter syllables: %d", len(source))
+	}
+
+	for i := 0; i < 20; i++ {
+		passphrase, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		seen := make(map[string]struct{})
+		for _, w := range bytes.Split(passphrase, []byte(p.Separator)) {
+			if _, ok := seen[string(w)]; ok {
+				t.Fatalf("Word %q is repeated: %q", w, passphrase)
+			}
+			seen[string(w)] = struct{}{}
+
+			if len(w) > 2 {
+				t.Errorf("Word %q is longer than the maximum", w)
+			}
+		}
+	}
+
+	p.Length = uint64(len(source))
+	if _, err := p.Generate(); err == nil {
+		t.Error("Expected not enough words error, got nil")
+	}
+}
+
+func TestPassphraseUniqueWordSpace(t *testing.T) {
+	invalid := map[string]*Passphrase{
+		"no list":             {Length: 30, List: NoList, Unique: true, MinWordLength: 1, MaxWordLength: 1},
+		"unsupported source":  {Length: 2, Source: constantSource("word"), Unique: true},
+		"small pronounceable": {Length: 11, Source: &Pronounceable{Onsets: []string{"b"}, Codas: []string{"t"}, MinSyllables: 1, MaxSyllables: 1}, Unique: true},
+	}
+	for k, tc := range invalid {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+
+	p := &Passphrase{Length: 26, List: NoList, Unique: true, MinWordLength: 1, MaxWordLength: 1}
+	if _, err := p.Generate(); err != nil {
+		t.Errorf("Generate() failed: %v", err)
+	}
+}
+
+func TestPassphraseWordLength(t *testing.T) {
+	cases := map[string]*Passphrase{
+		"No list":       {Length: 10, List: NoList, MinWordLength: 5, MaxWordLength: 6},
+		"Word list":     {Length: 10, List: WordList, MinWordLength: 5, MaxWordLength: 6},
+		"Syllable list": {Length: 10, List: SyllableList, MinWordLength: 4, MaxWordLength: 4},
+	}
+
+	for k, tc := range cases {
+		t.Run(k, func(t *testing.T) {
+			passphrase, err := tc.Generate()
+			if err != nil {
+				t.Fatalf("Generate() failed: %v", err)
+			}
+
+			for _, w := range bytes.Split(passphrase, []byte(" ")) {
+				if len(w) < int(tc.MinWordLength) || len(w) > int(tc.MaxWordLength) {
+					t.Errorf("Word %q is out of the length limits", w)
+				}
+			}
+		})
+	}
+
+	invalid := map[string]*Passphrase{
+		"min higher than max": {Length: 2, MinWordLength: 5, MaxWordLength: 3},
+		"no words":            {Length: 2, List: WordList, MinWordLength: 100},
+	}
+	for k, tc := range invalid {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
+
+func TestPassphraseUniqueEntropy(t *testing.T) {
+	p := &Passphrase{
+		Length:        3,
+		List:          WordList,
+		Unique:        true,
+		MinWordLength: 4,
+		MaxWordLength: 8,
+	}
+
+	n := 0
+	for _, w := range wordList {
+		if len(w) >= 4 && len(w) <= 8 {
+			n++
+		}
+	}
+
+	expected := math.Log2(float64(n)) + math.Log2(float64(n-1)) + math.Log2(float64(n-2))
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+// constantSource is a word source that always returns the same word.
+type constantSource string
+
+func (c constantSource) Word(int) []byte { return []byte(c) }
+
+func (constantSource) Entropy(int) float64 { return 0 }
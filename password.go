@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"slices"
 	"strings"
 )
 
@@ -24,6 +25,12 @@ type Level string
 // Password represents a sequence of characters required for access to a computer system.
 type Password struct {
 	pool []byte
+	// Length of the password being generated.
+	curLength uint64
+	// Characters of each level with a weight, used when Weights is set.
+	levelPools [][]byte
+	// Cumulative weights of levelPools.
+	cumWeights []float64
 
 	// Characters that will be part of the password.
 	Include string
@@ -33,8 +40,21 @@ type Password struct {
 	Levels []Level
 	// Password length.
 	Length uint64
+	// Password length range, when MaxLength is set the length is drawn from [MinLength, MaxLength]
+	// on every generation and Length is ignored.
+	MinLength uint64
+	MaxLength uint64
+	// Relative probability of each length in the range, starting from MinLength. If it's empty
+	// the length is drawn uniformly.
+	LengthWeights []float64
 	// Character repetition.
 	Repeat bool
+	// Probability weight of each level (relative, they don't need to sum 1).
+	//
+	// When set, every character is sampled by choosing a level first and then a character
+	// from it, independently of the number of characters each level has. All the levels must
+	// have a weight and repetition must be enabled.
+	Weights map[Level]float64
 }
 
 // NewPassword returns a random password.
@@ -64,9 +84,16 @@ func (p *Password) generate() ([]byte, error) {
 
 	p.generatePool()
 
-	if !p.Repeat && int(p.Length) > (len(p.pool)+len(p.Include)) {
+	p.curLength = p.Length
+	if p.MaxLength != 0 {
+		p.curLength = p.MaxLength
+	}
+	if !p.Repeat && int(p.curLength) > (len(p.pool)+len(p.Include)) {
 		return nil, errors.New("password length is higher than the pool and repetition is turned off")
 	}
+	if p.MaxLength != 0 {
+		p.curLength = randLength(p.MinLength, p.MaxLength, p.LengthWeights)
+	}
 
 	password := p.buildPassword()
 	password = p.sanitize(password)
@@ -87,8 +114,9 @@ func (p *Password) buildPassword() []byte {
 		password = p.randInsert(password, byte(c))
 	}
 
-	// Add one character of each level only if we can guarantee it
-	if int(p.Length) > len(p.Levels) {
+	// Add one character of each level only if we can guarantee it, weighted passwords
+	// skip this step as it would distort the distribution
+	if p.length() > len(p.Levels) && p.Weights == nil {
 		for _, lvl := range p.Levels {
 		repeat:
 			char := lvl[randInt(len(lvl))]
@@ -107,14 +135,34 @@ func (p *Password) buildPassword() []byte {
 	}
 
 	// Subtract the number of characters already added to the password from the total length
-	remaining := int(p.Length) - len(password)
+	remaining := p.length() - len(password)
 	for i := 0; i < remaining; i++ {
-		password = p.randInsert(password, p.pool[randInt(len(p.pool))])
+		password = p.randInsert(password, p.randChar())
 	}
 
 	return password
 }
 
+// randChar returns a random character from the pool, or from a level chosen by its weight
+// if Weights is set.
+func (p *Password) randChar() byte {
+	if p.Weights == nil {
+		return p.pool[randInt(len(p.pool))]
+	}
+
+	r := randFloat() * p.cumWeights[len(p.cumWeights)-1]
+	for i, w := range p.cumWeights {
+		if r < w {
+			lvl := p.levelPools[i]
+			return lvl[randInt(len(lvl))]
+		}
+	}
+
+	// Unreachable unless there is a rounding error, use the last level
+	lvl := p.levelPools[len(p.levelPools)-1]
+	return lvl[randInt(len(lvl))]
+}
+
 func (p *Password) generatePool() {
 	buf := getBuf()
 	unique := make(map[Level]struct{})
@@ -137,6 +185,37 @@ func (p *Password) generatePool() {
 			p.pool = append(p.pool[:idx], p.pool[idx+1:]...)
 		}
 	}
+
+	if p.Weights != nil {
+		p.generateLevelPools()
+	}
+}
+
+// generateLevelPools splits the characters of each weighted level and accumulates their weights.
+func (p *Password) generateLevelPools() {
+	p.levelPools = p.levelPools[:0]
+	p.cumWeights = p.cumWeights[:0]
+	unique := make(map[Level]struct{})
+	var total float64
+
+	for _, lvl := range p.Levels {
+		w := p.Weights[lvl]
+		if _, ok := unique[lvl]; ok || w == 0 {
+			continue
+		}
+		unique[lvl] = struct{}{}
+
+		chars := make([]byte, 0, len(lvl))
+		for i := 0; i < len(lvl); i++ {
+			if !strings.ContainsRune(p.Exclude, rune(lvl[i])) {
+				chars = append(chars, lvl[i])
+			}
+		}
+
+		total += w
+		p.levelPools = append(p.levelPools, chars)
+		p.cumWeights = append(p.cumWeights, total)
+	}
 }
 
 // randInsert returns password with char inserted in a random position and removes char from pool in
@@ -165,12 +244,12 @@ func (p *Password) sanitize(password []byte) []byte {
 	password = bytes.TrimSpace(password)
 	// In case any space was removed, generate new characters and add
 	// them to the password to meet the length required
-	if len(password) < int(p.Length) {
-		offset := int(p.Length) - len(password)
+	if len(password) < p.length() {
+		offset := p.length() - len(password)
 
 		for i := 0; i < offset; i++ {
 			// Add remaining characters in random positions
-			password = p.randInsert(password, p.pool[randInt(len(p.pool))])
+			password = p.randInsert(password, p.randChar())
 		}
 	}
 
@@ -184,8 +263,24 @@ repeat:
 	return password
 }
 
+// length returns the length of the password being generated.
+func (p *Password) length() int {
+	if p.curLength != 0 {
+		return int(p.curLength)
+	}
+	return int(p.Length)
+}
+
 func (p *Password) validateParams() error {
-	if p.Length < 1 {
+	if err := validateLengthRange(p.MinLength, p.MaxLength, p.LengthWeights); err != nil {
+		return err
+	}
+
+	minLength := p.Length
+	if p.MaxLength != 0 {
+		minLength = p.MinLength
+	}
+	if minLength < 1 {
 		return errors.New("invalid password length")
 	}
 
@@ -204,13 +299,52 @@ func (p *Password) validateParams() error {
 		}
 	}
 
-	if len(p.Include) > int(p.Length) {
+	if len(p.Include) > int(minLength) {
 		return errors.New("characters to include exceed the password length")
 	}
 
+	if err := p.validateWeights(); err != nil {
+		return err
+	}
+
 	return p.validateLevels()
 }
 
+// validateWeights checks that every level has a valid weight and that at least one of them is positive.
+func (p *Password) validateWeights() error {
+	if p.Weights == nil {
+		return nil
+	}
+
+	if !p.Repeat {
+		return errors.New("weights require character repetition")
+	}
+
+	for lvl := range p.Weights {
+		if !slices.Contains(p.Levels, lvl) {
+			return fmt.Errorf("weighted level %q is not used", lvl)
+		}
+	}
+
+	var total float64
+	for _, lvl := range p.Levels {
+		w, ok := p.Weights[lvl]
+		if !ok {
+			return fmt.Errorf("level %q has no weight", lvl)
+		}
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return fmt.Errorf("invalid weight for level %q: %v", lvl, w)
+		}
+		total += w
+	}
+
+	if total == 0 {
+		return errors.New("at least one weight must be higher than zero")
+	}
+
+	return nil
+}
+
 // validateLevels checks if Exclude contains all the characters of a level that is in Levels.
 func (p *Password) validateLevels() error {
 	for _, lvl := range p.Levels {
@@ -258,7 +392,25 @@ func (p *Password) validateLevels() error {
 }
 
 // Entropy returns the password entropy in bits.
+//
+// If Weights is set, it's computed from the probability of each character, included
+// characters are fixed and do not add entropy.
+//
+// If a length range is used, it's the entropy of the length plus the expected entropy of the
+// password given its length, weighted by LengthWeights.
 func (p *Password) Entropy() float64 {
+	if p.MaxLength != 0 {
+		return rangeEntropy(p.MinLength, p.MaxLength, p.LengthWeights, p.entropy)
+	}
+	return p.entropy(p.Length)
+}
+
+// entropy returns the entropy of a password of the given length.
+func (p *Password) entropy(length uint64) float64 {
+	if p.Weights != nil {
+		return p.weightedEntropy(length)
+	}
+
 	var poolLength int
 	unique := make(map[Level]struct{})
 
@@ -278,5 +430,48 @@ func (p *Password) Entropy() float64 {
 		}
 	}
 	poolLength += len(p.Include)
-	return math.Log2(math.Pow(float64(poolLength), float64(p.Length)))
+	return math.Log2(math.Pow(float64(poolLength), float64(length)))
+}
+
+// weightedEntropy returns the entropy of a password whose characters are sampled by level weights.
+func (p *Password) weightedEntropy(length uint64) float64 {
+	var total float64
+	levels := make([]Level, 0, len(p.Levels))
+	for _, lvl := range p.Levels {
+		if !slices.Contains(levels, lvl) {
+			levels = append(levels, lvl)
+			total += p.Weights[lvl]
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+
+	// Characters may be part of more than one level, accumulate their probabilities
+	probs := make([]float64, 256)
+	for _, lvl := range levels {
+		w := p.Weights[lvl]
+		if w == 0 {
+			continue
+		}
+
+		chars := make([]byte, 0, len(lvl))
+		for i := 0; i < len(lvl); i++ {
+			if !strings.ContainsRune(p.Exclude, rune(lvl[i])) {
+				chars = append(chars, lvl[i])
+			}
+		}
+		for _, c := range chars {
+			probs[c] += w / total / float64(len(chars))
+		}
+	}
+
+	var charEntropy float64
+	for _, prob := range probs {
+		if prob > 0 {
+			charEntropy -= prob * math.Log2(prob)
+		}
+	}
+
+	return charEntropy * float64(int(length)-len(p.Include))
 }
@@ -2,6 +2,7 @@ package atoll
 
 import (
 	"bytes"
+	"math"
 	"strings"
 	"testing"
 )
@@ -293,3 +294,187 @@ func TestPasswordEntropy(t *testing.T) {
 		t.Errorf("Expected %f, got %f", expected, got)
 	}
 }
+
+func TestWeightedPassword(t *testing.T) {
+	p := &Password{
+		Length:  64,
+		Levels:  []Level{Lower, Digit, Special},
+		Exclude: "xyz",
+		Repeat:  true,
+		Weights: map[Level]float64{Lower: 1, Digit: 1, Special: 0},
+	}
+
+	digits, total := 0, 0
+	for i := 0; i < 100; i++ {
+		password, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		if len(password) != int(p.Length) {
+			t.Errorf("Expected password to be %d characters long, got %d", p.Length, len(password))
+		}
+
+		if bytes.ContainsAny(password, string(Special)+p.Exclude) {
+			t.Errorf("Found undesired characters in %q", password)
+		}
+
+		for _, c := range password {
+			if bytes.IndexByte([]byte(Digit), c) != -1 {
+				digits++
+			}
+		}
+		total += len(password)
+	}
+
+	// Without weights digits would be 10/33 of the characters, the standard deviation of the
+	// frequency over 6400 characters is ~0.006
+	if freq := float64(digits) / float64(total); math.Abs(freq-0.5) > 0.05 {
+		t.Errorf("Expected digits to be ~50%% of the characters, got %.2f", freq)
+	}
+}
+
+func TestInvalidWeights(t *testing.T) {
+	cases := map[string]*Password{
+		"repetition disabled": {
+			Length:  10,
+			Levels:  []Level{Lower},
+			Weights: map[Level]float64{Lower: 1},
+		},
+		"missing weight": {
+			Length:  10,
+			Levels:  []Level{Lower, Digit},
+			Repeat:  true,
+			Weights: map[Level]float64{Lower: 1},
+		},
+		"unused level": {
+			Length:  10,
+			Levels:  []Level{Lower},
+			Repeat:  true,
+			Weights: map[Level]float64{Lower: 1, Digit: 1},
+		},
+		"negative weight": {
+			Length:  10,
+			Levels:  []Level{Lower, Digit},
+			Repeat:  true,
+			Weights: map[Level]float64{Lower: 1, Digit: -1},
+		},
+		"zero weights": {
+			Length:  10,
+			Levels:  []Level{Lower},
+			Repeat:  true,
+			Weights: map[Level]float64{Lower: 0},
+		},
+	}
+
+	for k, tc := range cases {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
+
+func TestWeightedPasswordEntropy(t *testing.T) {
+	cases := []struct {
+		p        *Password
+		desc     string
+		expected float64
+	}{
+		{
+			desc: "Proportional to the levels size",
+			p: &Password{
+				Length:  12,
+				Levels:  []Level{Lower, Digit},
+				Weights: map[Level]float64{Lower: 26, Digit: 10},
+			},
+			expected: 12 * math.Log2(36),
+		},
+		{
+			desc: "Half and half",
+			p: &Password{
+				Length:  10,
+				Levels:  []Level{Lower, Digit},
+				Exclude: "0123",
+				Weights: map[Level]float64{Lower: 1, Digit: 1},
+			},
+			expected: 10 * (0.5*math.Log2(52) + 0.5*math.Log2(12)),
+		},
+		{
+			desc: "Included characters",
+			p: &Password{
+				Length:  10,
+				Levels:  []Level{Upper, Special},
+				Include: "ab",
+				Weights: map[Level]float64{Upper: 1, Special: 0},
+			},
+			expected: 8 * math.Log2(26),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.p.Entropy()
+			if math.Abs(got-tc.expected) > 1e-9 {
+				t.Errorf("Expected %f, got %f", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPasswordLengthRange(t *testing.T) {
+	p := &Password{
+		MinLength: 8,
+		MaxLength: 12,
+		Levels:    []Level{Lower, Upper, Digit},
+		Include:   "1234",
+	}
+
+	lengths := make(map[int]struct{})
+	for i := 0; i < 200; i++ {
+		password, err := p.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		if len(password) < int(p.MinLength) || len(password) > int(p.MaxLength) {
+			t.Errorf("Password length %d is out of range", len(password))
+		}
+		lengths[len(password)] = struct{}{}
+	}
+
+	if len(lengths) != 5 {
+		t.Errorf("Expected 5 different lengths, got %d", len(lengths))
+	}
+
+	invalid := &Password{MinLength: 4, MaxLength: 10, Levels: []Level{Digit}, Include: "abcde"}
+	if _, err := invalid.Generate(); err == nil {
+		t.Error("Expected included characters to exceed the minimum length")
+	}
+}
+
+func TestPasswordLengthRangeEntropy(t *testing.T) {
+	p := &Password{
+		MinLength:     10,
+		MaxLength:     12,
+		LengthWeights: []float64{1, 2, 1},
+		Levels:        []Level{Lower},
+	}
+
+	bits := math.Log2(26)
+	expected := 1.5 + (0.25*10+0.5*11+0.25*12)*bits
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected entropy %f, got %f", expected, got)
+	}
+
+	if got := math.Log2(Keyspace(p)); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected keyspace to be 2^%f, got 2^%f", expected, got)
+	}
+
+	// Almost every password is 8 characters long, the unlikely lengths barely add entropy
+	weights := make([]float64, 13)
+	weights[0], weights[12] = 1000, 0.001
+	p = &Password{MinLength: 8, MaxLength: 20, LengthWeights: weights, Levels: []Level{Lower}}
+	if got := p.Entropy(); got < 8*bits || got > 8*bits+0.1 {
+		t.Errorf("Expected entropy close to %f, got %f", 8*bits, got)
+	}
+}
@@ -0,0 +1,125 @@
+package atoll
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// pgpIndex maps the lowercased words of the PGP word list to the byte they encode, words of the odd
+// list are offset by 256.
+var pgpIndex = sync.OnceValue(func() map[string]int {
+	index := make(map[string]int, len(pgpEvenList)+len(pgpOddList))
+	for i, word := range pgpEvenList {
+		index[strings.ToLower(string(word))] = i
+	}
+	for i, word := range pgpOddList {
+		index[strings.ToLower(string(word))] = 256 + i
+	}
+	return index
+})
+
+// EncodePGPWords returns the data encoded with the PGP word list. Bytes in even positions (starting
+// from zero) are encoded with two syllable words and the ones in odd positions with three syllable
+// words, so they are easy to tell apart when read aloud.
+func EncodePGPWords(data []byte) []string {
+	words := make([]string, len(data))
+	for i, b := range data {
+		words[i] = string(pgpList(i)[b])
+	}
+	return words
+}
+
+// DecodePGPWords returns the data encoded by the PGP words, which are case-insensitive.
+//
+// As even and odd positions use different lists, words that were skipped, repeated or swapped
+// are detected. If a word is not in the list, the error suggests the closest one.
+func DecodePGPWords(words []string) ([]byte, error) {
+	index := pgpIndex()
+	data := make([]byte, len(words))
+	for i, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		idx, ok := index[word]
+		if !ok {
+			return nil, fmt.Errorf("atoll: unknown word %q in position %d, did you mean %q?", word, i+1, closestWord(word, pgpList(i)))
+		}
+
+		if idx/256 != i%2 {
+			return nil, fmt.Errorf("atoll: word %q in position %d belongs to the %s list, a word may be missing or repeated",
+				word, i+1, pgpParity(idx/256))
+		}
+		data[i] = byte(idx % 256)
+	}
+
+	return data, nil
+}
+
+// PGPWords is a word source that generates random words of the PGP word list alternating between the
+// even and the odd lists, so passphrases can be decoded with DecodePGPWords. Each word adds 8 bits
+// of entropy.
+//
+// Words must keep their position and every byte must be possible, so included and excluded words
+// and Unique aren't supported.
+type PGPWords struct{}
+
+// Word returns a random word of the list corresponding to the i-th position.
+func (PGPWords) Word(i int) []byte {
+	return pgpList(i)[randInt(256)]
+}
+
+// Entropy returns the entropy in bits of a word.
+func (PGPWords) Entropy(int) float64 {
+	return 8
+}
+
+// meanLetters returns the mean number of letters of the words of the list corresponding to the i-th
+// position.
+func (PGPWords) meanLetters(i int) float64 {
+	var letters int
+	for _, word := range pgpList(i) {
+		letters += len(word)
+	}
+	return float64(letters) / 256
+}
+
+// validateOptions rejects the passphrase options that would break decoding or lower the entropy.
+func (PGPWords) validateOptions(p *Passphrase) error {
+	if len(p.Include) != 0 {
+		return errors.New("PGP words cannot be combined with included words")
+	}
+	if len(p.Exclude) != 0 {
+		return errors.New("PGP words cannot be combined with excluded words")
+	}
+	if p.Unique {
+		return errors.New("PGP words cannot be unique")
+	}
+	return nil
+}
+
+// VerificationCode returns a random code of n bytes written as PGP words separated by spaces, so
+// it can be read aloud and compared over the phone.
+func VerificationCode(n uint64) ([]byte, error) {
+	p := &Passphrase{
+		Length: n,
+		Source: PGPWords{},
+	}
+
+	return p.Generate()
+}
+
+// pgpList returns the PGP list used for the i-th position.
+func pgpList(i int) [][]byte {
+	if i%2 == 0 {
+		return pgpEvenList
+	}
+	return pgpOddList
+}
+
+// pgpParity returns the name of the list used for the given parity.
+func pgpParity(parity int) string {
+	if parity == 0 {
+		return "even"
+	}
+	return "odd"
+}
@@ -0,0 +1,521 @@
+package atoll
+
+// pgpEvenList holds the two syllable words of the PGP word list, used for bytes in even positions.
+var pgpEvenList = [][]byte{
+	[]byte("aardvark"),
+	[]byte("absurd"),
+	[]byte("accrue"),
+	[]byte("acme"),
+	[]byte("adrift"),
+	[]byte("adult"),
+	[]byte("afflict"),
+	[]byte("ahead"),
+	[]byte("aimless"),
+	[]byte("Algol"),
+	[]byte("allow"),
+	[]byte("alone"),
+	[]byte("ammo"),
+	[]byte("ancient"),
+	[]byte("apple"),
+	[]byte("artist"),
+	[]byte("assume"),
+	[]byte("Athens"),
+	[]byte("atlas"),
+	[]byte("Aztec"),
+	[]byte("baboon"),
+	[]byte("backfield"),
+	[]byte("backward"),
+	[]byte("banjo"),
+	[]byte("beaming"),
+	[]byte("bedlamp"),
+	[]byte("beehive"),
+	[]byte("beeswax"),
+	[]byte("befriend"),
+	[]byte("Belfast"),
+	[]byte("berserk"),
+	[]byte("billiard"),
+	[]byte("bison"),
+	[]byte("blackjack"),
+	[]byte("blockade"),
+	[]byte("blowtorch"),
+	[]byte("bluebird"),
+	[]byte("bombast"),
+	[]byte("bookshelf"),
+	[]byte("brackish"),
+	[]byte("breadline"),
+	[]byte("breakup"),
+	[]byte("brickyard"),
+	[]byte("briefcase"),
+	[]byte("Burbank"),
+	[]byte("button"),
+	[]byte("buzzard"),
+	[]byte("cement"),
+	[]byte("chairlift"),
+	[]byte("chatter"),
+	[]byte("checkup"),
+	[]byte("chisel"),
+	[]byte("choking"),
+	[]byte("chopper"),
+	[]byte("Christmas"),
+	[]byte("clamshell"),
+	[]byte("classic"),
+	[]byte("classroom"),
+	[]byte("cleanup"),
+	[]byte("clockwork"),
+	[]byte("cobra"),
+	[]byte("commence"),
+	[]byte("concert"),
+	[]byte("cowbell"),
+	[]byte("crackdown"),
+	[]byte("cranky"),
+	[]byte("crowfoot"),
+	[]byte("crucial"),
+	[]byte("crumpled"),
+	[]byte("crusade"),
+	[]byte("cubic"),
+	[]byte("dashboard"),
+	[]byte("deadbolt"),
+	[]byte("deckhand"),
+	[]byte("dogsled"),
+	[]byte("dragnet"),
+	[]byte("drainage"),
+	[]byte("dreadful"),
+	[]byte("drifter"),
+	[]byte("dropper"),
+	[]byte("drumbeat"),
+	[]byte("drunken"),
+	[]byte("Dupont"),
+	[]byte("dwelling"),
+	[]byte("eating"),
+	[]byte("edict"),
+	[]byte("egghead"),
+	[]byte("eightball"),
+	[]byte("endorse"),
+	[]byte("endow"),
+	[]byte("enlist"),
+	[]byte("erase"),
+	[]byte("escape"),
+	[]byte("exceed"),
+	[]byte("eyeglass"),
+	[]byte("eyetooth"),
+	[]byte("facial"),
+	[]byte("fallout"),
+	[]byte("flagpole"),
+	[]byte("flatfoot"),
+	[]byte("flytrap"),
+	[]byte("fracture"),
+	[]byte("framework"),
+	[]byte("freedom"),
+	[]byte("frighten"),
+	[]byte("gazelle"),
+	[]byte("Geiger"),
+	[]byte("glitter"),
+	[]byte("glucose"),
+	[]byte("goggles"),
+	[]byte("goldfish"),
+	[]byte("gremlin"),
+	[]byte("guidance"),
+	[]byte("hamlet"),
+	[]byte("highchair"),
+	[]byte("hockey"),
+	[]byte("indoors"),
+	[]byte("indulge"),
+	[]byte("inverse"),
+	[]byte("involve"),
+	[]byte("island"),
+	[]byte("jawbone"),
+	[]byte("keyboard"),
+	[]byte("kickoff"),
+	[]byte("kiwi"),
+	[]byte("klaxon"),
+	[]byte("locale"),
+	[]byte("lockup"),
+	[]byte("merit"),
+	[]byte("minnow"),
+	[]byte("miser"),
+	[]byte("Mohawk"),
+	[]byte("mural"),
+	[]byte("music"),
+	[]byte("necklace"),
+	[]byte("Neptune"),
+	[]byte("newborn"),
+	[]byte("nightbird"),
+	[]byte("Oakland"),
+	[]byte("obtuse"),
+	[]byte("offload"),
+	[]byte("optic"),
+	[]byte("orca"),
+	[]byte("payday"),
+	[]byte("peachy"),
+	[]byte("pheasant"),
+	[]byte("physique"),
+	[]byte("playhouse"),
+	[]byte("Pluto"),
+	[]byte("preclude"),
+	[]byte("prefer"),
+	[]byte("preshrunk"),
+	[]byte("printer"),
+	[]byte("prowler"),
+	[]byte("pupil"),
+	[]byte("puppy"),
+	[]byte("python"),
+	[]byte("quadrant"),
+	[]byte("quiver"),
+	[]byte("quota"),
+	[]byte("ragtime"),
+	[]byte("ratchet"),
+	[]byte("rebirth"),
+	[]byte("reform"),
+	[]byte("regain"),
+	[]byte("reindeer"),
+	[]byte("rematch"),
+	[]byte("repay"),
+	[]byte("retouch"),
+	[]byte("revenge"),
+	[]byte("reward"),
+	[]byte("rhythm"),
+	[]byte("ribcage"),
+	[]byte("ringbolt"),
+	[]byte("robust"),
+	[]byte("rocker"),
+	[]byte("ruffled"),
+	[]byte("sailboat"),
+	[]byte("sawdust"),
+	[]byte("scallion"),
+	[]byte("scenic"),
+	[]byte("scorecard"),
+	[]byte("Scotland"),
+	[]byte("seabird"),
+	[]byte("select"),
+	[]byte("sentence"),
+	[]byte("shadow"),
+	[]byte("shamrock"),
+	[]byte("showgirl"),
+	[]byte("skullcap"),
+	[]byte("skydive"),
+	[]byte("slingshot"),
+	[]byte("slowdown"),
+	[]byte("snapline"),
+	[]byte("snapshot"),
+	[]byte("snowcap"),
+	[]byte("snowslide"),
+	[]byte("solo"),
+	[]byte("southward"),
+	[]byte("soybean"),
+	[]byte("spaniel"),
+	[]byte("spearhead"),
+	[]byte("spellbind"),
+	[]byte("spheroid"),
+	[]byte("spigot"),
+	[]byte("spindle"),
+	[]byte("spyglass"),
+	[]byte("stagehand"),
+	[]byte("stagnate"),
+	[]byte("stairway"),
+	[]byte("standard"),
+	[]byte("stapler"),
+	[]byte("steamship"),
+	[]byte("sterling"),
+	[]byte("stockman"),
+	[]byte("stopwatch"),
+	[]byte("stormy"),
+	[]byte("sugar"),
+	[]byte("surmount"),
+	[]byte("suspense"),
+	[]byte("sweatband"),
+	[]byte("swelter"),
+	[]byte("tactics"),
+	[]byte("talon"),
+	[]byte("tapeworm"),
+	[]byte("tempest"),
+	[]byte("tiger"),
+	[]byte("tissue"),
+	[]byte("tonic"),
+	[]byte("topmost"),
+	[]byte("tracker"),
+	[]byte("transit"),
+	[]byte("trauma"),
+	[]byte("treadmill"),
+	[]byte("Trojan"),
+	[]byte("trouble"),
+	[]byte("tumor"),
+	[]byte("tunnel"),
+	[]byte("tycoon"),
+	[]byte("uncut"),
+	[]byte("unearth"),
+	[]byte("unwind"),
+	[]byte("uproot"),
+	[]byte("upset"),
+	[]byte("upshot"),
+	[]byte("vapor"),
+	[]byte("village"),
+	[]byte("virus"),
+	[]byte("Vulcan"),
+	[]byte("waffle"),
+	[]byte("wallet"),
+	[]byte("watchword"),
+	[]byte("wayside"),
+	[]byte("willow"),
+	[]byte("woodlark"),
+	[]byte("Zulu"),
+}
+
+// pgpOddList holds the three syllable words of the PGP word list, used for bytes in odd positions.
+var pgpOddList = [][]byte{
+	[]byte("adroitness"),
+	[]byte("adviser"),
+	[]byte("aftermath"),
+	[]byte("aggregate"),
+	[]byte("alkali"),
+	[]byte("almighty"),
+	[]byte("amulet"),
+	[]byte("amusement"),
+	[]byte("antenna"),
+	[]byte("applicant"),
+	[]byte("Apollo"),
+	[]byte("armistice"),
+	[]byte("article"),
+	[]byte("asteroid"),
+	[]byte("Atlantic"),
+	[]byte("atmosphere"),
+	[]byte("autopsy"),
+	[]byte("Babylon"),
+	[]byte("backwater"),
+	[]byte("barbecue"),
+	[]byte("belowground"),
+	[]byte("bifocals"),
+	[]byte("bodyguard"),
+	[]byte("bookseller"),
+	[]byte("borderline"),
+	[]byte("bottomless"),
+	[]byte("Bradbury"),
+	[]byte("bravado"),
+	[]byte("Brazilian"),
+	[]byte("breakaway"),
+	[]byte("Burlington"),
+	[]byte("businessman"),
+	[]byte("butterfat"),
+	[]byte("Camelot"),
+	[]byte("candidate"),
+	[]byte("cannonball"),
+	[]byte("Capricorn"),
+	[]byte("caravan"),
+	[]byte("caretaker"),
+	[]byte("celebrate"),
+	[]byte("cellulose"),
+	[]byte("certify"),
+	[]byte("chambermaid"),
+	[]byte("Cherokee"),
+	[]byte("Chicago"),
+	[]byte("clergyman"),
+	[]byte("coherence"),
+	[]byte("combustion"),
+	[]byte("commando"),
+	[]byte("company"),
+	[]byte("component"),
+	[]byte("concurrent"),
+	[]byte("confidence"),
+	[]byte("conformist"),
+	[]byte("congregate"),
+	[]byte("consensus"),
+	[]byte("consulting"),
+	[]byte("corporate"),
+	[]byte("corrosion"),
+	[]byte("councilman"),
+	[]byte("crossover"),
+	[]byte("crucifix"),
+	[]byte("cumbersome"),
+	[]byte("customer"),
+	[]byte("Dakota"),
+	[]byte("decadence"),
+	[]byte("December"),
+	[]byte("decimal"),
+	[]byte("designing"),
+	[]byte("detector"),
+	[]byte("detergent"),
+	[]byte("determine"),
+	[]byte("dictator"),
+	[]byte("dinosaur"),
+	[]byte("direction"),
+	[]byte("disable"),
+	[]byte("disbelief"),
+	[]byte("disruptive"),
+	[]byte("distortion"),
+	[]byte("document"),
+	[]byte("embezzle"),
+	[]byte("enchanting"),
+	[]byte("enrollment"),
+	[]byte("enterprise"),
+	[]byte("equation"),
+	[]byte("equipment"),
+	[]byte("escapade"),
+	[]byte("Eskimo"),
+	[]byte("everyday"),
+	[]byte("examine"),
+	[]byte("existence"),
+	[]byte("exodus"),
+	[]byte("fascinate"),
+	[]byte("filament"),
+	[]byte("finicky"),
+	[]byte("forever"),
+	[]byte("fortitude"),
+	[]byte("frequency"),
+	[]byte("gadgetry"),
+	[]byte("Galveston"),
+	[]byte("getaway"),
+	[]byte("glossary"),
+	[]byte("gossamer"),
+	[]byte("graduate"),
+	[]byte("gravity"),
+	[]byte("guitarist"),
+	[]byte("hamburger"),
+	[]byte("Hamilton"),
+	[]byte("handiwork"),
+	[]byte("hazardous"),
+	[]byte("headwaters"),
+	[]byte("hemisphere"),
+	[]byte("hesitate"),
+	[]byte("hideaway"),
+	[]byte("holiness"),
+	[]byte("hurricane"),
+	[]byte("hydraulic"),
+	[]byte("impartial"),
+	[]byte("impetus"),
+	[]byte("inception"),
+	[]byte("indigo"),
+	[]byte("inertia"),
+	[]byte("infancy"),
+	[]byte("inferno"),
+	[]byte("informant"),
+	[]byte("insincere"),
+	[]byte("insurgent"),
+	[]byte("integrate"),
+	[]byte("intention"),
+	[]byte("inventive"),
+	[]byte("Istanbul"),
+	[]byte("Jamaica"),
+	[]byte("Jupiter"),
+	[]byte("leprosy"),
+	[]byte("letterhead"),
+	[]byte("liberty"),
+	[]byte("maritime"),
+	[]byte("matchmaker"),
+	[]byte("maverick"),
+	[]byte("Medusa"),
+	[]byte("megaton"),
+	[]byte("microscope"),
+	[]byte("microwave"),
+	[]byte("midsummer"),
+	[]byte("millionaire"),
+	[]byte("miracle"),
+	[]byte("misnomer"),
+	[]byte("molasses"),
+	[]byte("molecule"),
+	[]byte("Montana"),
+	[]byte("monument"),
+	[]byte("mosquito"),
+	[]byte("narrative"),
+	[]byte("nebula"),
+	[]byte("newsletter"),
+	[]byte("Norwegian"),
+	[]byte("October"),
+	[]byte("Ohio"),
+	[]byte("onlooker"),
+	[]byte("opulent"),
+	[]byte("Orlando"),
+	[]byte("outfielder"),
+	[]byte("Pacific"),
+	[]byte("pandemic"),
+	[]byte("Pandora"),
+	[]byte("paperweight"),
+	[]byte("paragon"),
+	[]byte("paragraph"),
+	[]byte("paramount"),
+	[]byte("passenger"),
+	[]byte("pedigree"),
+	[]byte("Pegasus"),
+	[]byte("penetrate"),
+	[]byte("perceptive"),
+	[]byte("performance"),
+	[]byte("pharmacy"),
+	[]byte("phonetic"),
+	[]byte("photograph"),
+	[]byte("pioneer"),
+	[]byte("pocketful"),
+	[]byte("politeness"),
+	[]byte("positive"),
+	[]byte("potato"),
+	[]byte("processor"),
+	[]byte("provincial"),
+	[]byte("proximate"),
+	[]byte("puberty"),
+	[]byte("publisher"),
+	[]byte("pyramid"),
+	[]byte("quantity"),
+	[]byte("racketeer"),
+	[]byte("rebellion"),
+	[]byte("recipe"),
+	[]byte("recover"),
+	[]byte("repellent"),
+	[]byte("replica"),
+	[]byte("reproduce"),
+	[]byte("resistor"),
+	[]byte("responsive"),
+	[]byte("retraction"),
+	[]byte("retrieval"),
+	[]byte("retrospect"),
+	[]byte("revenue"),
+	[]byte("revival"),
+	[]byte("revolver"),
+	[]byte("sandalwood"),
+	[]byte("sardonic"),
+	[]byte("Saturday"),
+	[]byte("savagery"),
+	[]byte("scavenger"),
+	[]byte("sensation"),
+	[]byte("sociable"),
+	[]byte("souvenir"),
+	[]byte("specialist"),
+	[]byte("speculate"),
+	[]byte("stethoscope"),
+	[]byte("stupendous"),
+	[]byte("supportive"),
+	[]byte("surrender"),
+	[]byte("suspicious"),
+	[]byte("sympathy"),
+	[]byte("tambourine"),
+	[]byte("telephone"),
+	[]byte("therapist"),
+	[]byte("tobacco"),
+	[]byte("tolerance"),
+	[]byte("tomorrow"),
+	[]byte("torpedo"),
+	[]byte("tradition"),
+	[]byte("travesty"),
+	[]byte("trombonist"),
+	[]byte("truncated"),
+	[]byte("typewriter"),
+	[]byte("ultimate"),
+	[]byte("undaunted"),
+	[]byte("underfoot"),
+	[]byte("unicorn"),
+	[]byte("unify"),
+	[]byte("universe"),
+	[]byte("unravel"),
+	[]byte("upcoming"),
+	[]byte("vacancy"),
+	[]byte("vagabond"),
+	[]byte("vertigo"),
+	[]byte("Virginia"),
+	[]byte("visitor"),
+	[]byte("vocalist"),
+	[]byte("voyager"),
+	[]byte("warranty"),
+	[]byte("Waterloo"),
+	[]byte("whimsical"),
+	[]byte("Wichita"),
+	[]byte("Wilmington"),
+	[]byte("Wyoming"),
+	[]byte("yesteryear"),
+	[]byte("Yucatan"),
+}
@@ -0,0 +1,98 @@
+package atoll
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEncodePGPWords(t *testing.T) {
+	// Example from https://en.wikipedia.org/wiki/PGP_word_list
+	data, err := hex.DecodeString("E58294F2E9A227486E8B061B31CC528FD7FA3F19")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "topmost Istanbul Pluto vagabond treadmill Pacific brackish dictator goldfish Medusa " +
+		"afflict bravado chatter revolver Dupont midsummer stopwatch whimsical cowbell bottomless"
+	words := EncodePGPWords(data)
+	if got := strings.Join(words, " "); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	decoded, err := DecodePGPWords(strings.Fields(strings.ToLower(expected)))
+	if err != nil {
+		t.Fatalf("DecodePGPWords() failed: %v", err)
+	}
+	if !bytes.Equal(decoded, data) {
+		t.Errorf("Expected %X, got %X", data, decoded)
+	}
+}
+
+func TestDecodePGPWordsErrors(t *testing.T) {
+	words := EncodePGPWords([]byte{0x00, 0x01, 0x02, 0x03})
+
+	cases := map[string]struct {
+		words    []string
+		expected string
+	}{
+		"skipped":  {words: []string{words[0], words[2], words[3]}, expected: "even list"},
+		"repeated": {words: []string{words[0], words[1], words[1], words[2]}, expected: "odd list"},
+		"typo":     {words: []string{words[0], "adviserr"}, expected: `did you mean "adviser"`},
+	}
+
+	for name, tc := range cases {
+		_, err := DecodePGPWords(tc.words)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.expected, err)
+		}
+	}
+}
+
+func TestPGPLists(t *testing.T) {
+	seen := make(map[string]struct{}, 512)
+	for _, list := range [][][]byte{pgpEvenList, pgpOddList} {
+		if len(list) != 256 {
+			t.Errorf("Expected 256 words, got %d", len(list))
+		}
+		for _, word := range list {
+			w := strings.ToLower(string(word))
+			if _, ok := seen[w]; ok {
+				t.Errorf("Repeated word %q", word)
+			}
+			seen[w] = struct{}{}
+		}
+	}
+}
+
+func TestVerificationCode(t *testing.T) {
+	code, err := VerificationCode(6)
+	if err != nil {
+		t.Fatalf("VerificationCode() failed: %v", err)
+	}
+
+	words := strings.Fields(string(code))
+	if len(words) != 6 {
+		t.Fatalf("Expected 6 words, got %q", code)
+	}
+	if _, err := DecodePGPWords(words); err != nil {
+		t.Errorf("Failed decoding %q: %v", code, err)
+	}
+
+	p := &Passphrase{Length: 6, Source: PGPWords{}}
+	if got := p.Entropy(); got != 48 {
+		t.Errorf("Expected 48, got %f", got)
+	}
+
+	invalid := map[string]*Passphrase{
+		"included words": {Length: 6, Source: PGPWords{}, Include: []string{"aardvark"}},
+		"excluded words": {Length: 6, Source: PGPWords{}, Exclude: []string{"aardvark"}},
+		"unique":         {Length: 6, Source: PGPWords{}, Unique: true},
+	}
+	for k, tc := range invalid {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
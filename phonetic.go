@@ -0,0 +1,205 @@
+package atoll
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// PhoneticAlphabet represents a spelling alphabet used to read secrets aloud.
+type PhoneticAlphabet uint8
+
+// Spelling alphabets.
+const (
+	// NATOAlphabet is the NATO/ICAO alphabet: alfa, bravo, charlie... Digits use the ICAO
+	// pronunciations (tree, fife, niner).
+	NATOAlphabet PhoneticAlphabet = iota
+	// GermanAlphabet is the German DIN 5009 (2022) alphabet, made of city names: Aachen, Berlin, Chemnitz...
+	GermanAlphabet
+	// SpanishAlphabet is the Spanish alphabet commonly used on the phone: Antonio, Barcelona, Carmen...
+	SpanishAlphabet
+)
+
+// phoneticWords holds the words of an alphabet.
+type phoneticWords struct {
+	letters [26]string
+	digits  [10]string
+	// Words said before uppercase letters, the first one is used when spelling.
+	capital []string
+	// Alternative spellings accepted when parsing.
+	aliases map[string]byte
+}
+
+var phoneticAlphabets = map[PhoneticAlphabet]*phoneticWords{
+	NATOAlphabet: {
+		letters: [26]string{
+			"alfa", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india", "juliett",
+			"kilo", "lima", "mike", "november", "oscar", "papa", "quebec", "romeo", "sierra", "tango",
+			"uniform", "victor", "whiskey", "xray", "yankee", "zulu",
+		},
+		digits:  [10]string{"zero", "one", "two", "tree", "four", "fife", "six", "seven", "eight", "niner"},
+		capital: []string{"capital"},
+		aliases: map[string]byte{
+			"alpha": 'a', "juliet": 'j', "x-ray": 'x', "three": '3', "five": '5', "nine": '9',
+		},
+	},
+	GermanAlphabet: {
+		letters: [26]string{
+			"Aachen", "Berlin", "Chemnitz", "Düsseldorf", "Essen", "Frankfurt", "Goslar", "Hamburg",
+			"Ingelheim", "Jena", "Köln", "Leipzig", "München", "Nürnberg", "Offenbach", "Potsdam",
+			"Quickborn", "Rostock", "Salzwedel", "Tübingen", "Unna", "Völklingen", "Wuppertal", "Xanten",
+			"Ypsilon", "Zwickau",
+		},
+		digits:  [10]string{"null", "eins", "zwo", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun"},
+		capital: []string{"groß", "gross"},
+		aliases: map[string]byte{"zwei": '2'},
+	},
+	SpanishAlphabet: {
+		letters: [26]string{
+			"Antonio", "Barcelona", "Carmen", "Dolores", "Enrique", "Francia", "González", "Historia",
+			"Inés", "José", "Kilo", "Lorenzo", "Madrid", "Navarra", "Oviedo", "París", "Querido", "Ramón",
+			"Sábado", "Toledo", "Úrsula", "Valencia", "Washington", "Xilófono", "Yegua", "Zaragoza",
+		},
+		digits:  [10]string{"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve"},
+		capital: []string{"mayúscula", "mayuscula"},
+	},
+}
+
+// specialNames holds the spoken names of the characters that aren't letters or digits, they are
+// the same for every alphabet and written as a single word so they can be parsed back.
+var specialNames = map[byte]string{
+	' ':  "space",
+	'&':  "ampersand",
+	'$':  "dollar",
+	'%':  "percent",
+	'@':  "at-sign",
+	'#':  "hash",
+	'|':  "pipe",
+	'/':  "slash",
+	'\\': "backslash",
+	'=':  "equals",
+	'"':  "double-quote",
+	'*':  "asterisk",
+	'~':  "tilde",
+	'^':  "caret",
+	'`':  "backtick",
+	'\'': "single-quote",
+	'.':  "period",
+	'?':  "question-mark",
+	'!':  "exclamation-mark",
+	',':  "comma",
+	';':  "semicolon",
+	':':  "colon",
+	'-':  "hyphen",
+	'+':  "plus",
+	'_':  "underscore",
+	'(':  "left-parenthesis",
+	')':  "right-parenthesis",
+	'{':  "left-brace",
+	'}':  "right-brace",
+	'[':  "left-bracket",
+	']':  "right-bracket",
+	'<':  "less-than",
+	'>':  "greater-than",
+}
+
+// Phonetic returns the secret spelled with the words of the alphabet separated by spaces, so it can
+// be read over the phone without ambiguities.
+//
+// Uppercase letters are preceded by a word that indicates it ("capital" in the NATO alphabet) and
+// every other character is spelled with its name: "Ab3!" becomes "capital alfa bravo tree exclamation-mark".
+func Phonetic(secret []byte, alphabet PhoneticAlphabet) ([]byte, error) {
+	words, ok := phoneticAlphabets[alphabet]
+	if !ok {
+		return nil, fmt.Errorf("atoll: invalid phonetic alphabet: %d", alphabet)
+	}
+
+	spelled := make([]string, 0, len(secret))
+	for _, c := range secret {
+		switch {
+		case c >= 'a' && c <= 'z':
+			spelled = append(spelled, words.letters[c-'a'])
+		case c >= 'A' && c <= 'Z':
+			spelled = append(spelled, words.capital[0], words.letters[c-'A'])
+		case c >= '0' && c <= '9':
+			spelled = append(spelled, words.digits[c-'0'])
+		default:
+			name, ok := specialNames[c]
+			if !ok {
+				return nil, fmt.Errorf("atoll: character %q has no phonetic name", c)
+			}
+			spelled = append(spelled, name)
+		}
+	}
+
+	return []byte(strings.Join(spelled, " ")), nil
+}
+
+// ParsePhonetic returns the secret spelled with the words of the alphabet, it's the reverse of Phonetic.
+//
+// Words are case-insensitive and can be separated by any white space.
+func ParsePhonetic(phonetic []byte, alphabet PhoneticAlphabet) ([]byte, error) {
+	words, ok := phoneticAlphabets[alphabet]
+	if !ok {
+		return nil, fmt.Errorf("atoll: invalid phonetic alphabet: %d", alphabet)
+	}
+
+	index := words.index()
+	fields := bytes.Fields(phonetic)
+	secret := make([]byte, 0, len(fields))
+	capital := false
+	for i, field := range fields {
+		word := strings.ToLower(string(field))
+		if !utf8.ValidString(word) {
+			return nil, fmt.Errorf("atoll: word %d contains invalid characters", i+1)
+		}
+
+		if slices.Contains(words.capital, word) {
+			if capital {
+				return nil, fmt.Errorf("atoll: repeated %q in position %d", field, i+1)
+			}
+			capital = true
+			continue
+		}
+
+		c, ok := index[word]
+		if !ok {
+			return nil, fmt.Errorf("atoll: unknown word %q in position %d", field, i+1)
+		}
+
+		if capital {
+			if c < 'a' || c > 'z' {
+				return nil, fmt.Errorf("atoll: %q in position %d is not a letter", field, i+1)
+			}
+			c = upper(c)
+			capital = false
+		}
+		secret = append(secret, c)
+	}
+
+	if capital {
+		return nil, fmt.Errorf("atoll: missing letter after %q", words.capital[0])
+	}
+
+	return secret, nil
+}
+
+// index returns the characters spelled by each lowercased word of the alphabet.
+func (w *phoneticWords) index() map[string]byte {
+	index := make(map[string]byte, len(w.letters)+len(w.digits)+len(specialNames)+len(w.aliases))
+	for i, word := range w.letters {
+		index[strings.ToLower(word)] = byte('a' + i)
+	}
+	for i, word := range w.digits {
+		index[word] = byte('0' + i)
+	}
+	for c, name := range specialNames {
+		index[name] = c
+	}
+	for alias, c := range w.aliases {
+		index[alias] = c
+	}
+	return index
+}
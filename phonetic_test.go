@@ -0,0 +1,100 @@
+package atoll
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPhonetic(t *testing.T) {
+	got, err := Phonetic([]byte("Ab3! x"), NATOAlphabet)
+	if err != nil {
+		t.Fatalf("Phonetic() failed: %v", err)
+	}
+
+	expected := "capital alfa bravo tree exclamation-mark space xray"
+	if string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	got, err = Phonetic([]byte("Dz2"), GermanAlphabet)
+	if err != nil {
+		t.Fatalf("Phonetic() failed: %v", err)
+	}
+
+	expected = "groß Düsseldorf Zwickau zwo"
+	if string(got) != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestParsePhonetic(t *testing.T) {
+	secret := []byte(Lower + Upper + Digit + Space + Special)
+	for _, alphabet := range []PhoneticAlphabet{NATOAlphabet, GermanAlphabet, SpanishAlphabet} {
+		phonetic, err := Phonetic(secret, alphabet)
+		if err != nil {
+			t.Fatalf("Phonetic() failed: %v", err)
+		}
+
+		got, err := ParsePhonetic([]byte(strings.ToUpper(string(phonetic))), alphabet)
+		if err != nil {
+			t.Fatalf("ParsePhonetic() failed: %v", err)
+		}
+		if string(got) != string(secret) {
+			t.Errorf("Expected %q, got %q", secret, got)
+		}
+	}
+
+	got, err := ParsePhonetic([]byte("Capital Alpha\tjuliet\n nine"), NATOAlphabet)
+	if err != nil {
+		t.Fatalf("ParsePhonetic() failed: %v", err)
+	}
+	if string(got) != "Aj9" {
+		t.Errorf("Expected %q, got %q", "Aj9", got)
+	}
+}
+
+func TestPhoneticWords(t *testing.T) {
+	for alphabet, words := range phoneticAlphabets {
+		// Every word must spell a single character
+		index := words.index()
+		expected := len(words.letters) + len(words.digits) + len(specialNames) + len(words.aliases)
+		if len(index) != expected {
+			t.Errorf("Alphabet %d: expected %d different words, got %d", alphabet, expected, len(index))
+		}
+		for _, capital := range words.capital {
+			if _, ok := index[capital]; ok {
+				t.Errorf("Alphabet %d: %q spells a character", alphabet, capital)
+			}
+		}
+	}
+
+	for _, c := range []byte(Space + Special) {
+		if _, ok := specialNames[c]; !ok {
+			t.Errorf("Character %q has no name", c)
+		}
+	}
+}
+
+func TestInvalidPhonetic(t *testing.T) {
+	if _, err := Phonetic([]byte("a"), PhoneticAlphabet(9)); err == nil {
+		t.Error("Expected invalid alphabet error, got nil")
+	}
+	if _, err := Phonetic([]byte("ñ"), NATOAlphabet); err == nil {
+		t.Error("Expected invalid character error, got nil")
+	}
+	if _, err := ParsePhonetic([]byte("alfa"), PhoneticAlphabet(9)); err == nil {
+		t.Error("Expected invalid alphabet error, got nil")
+	}
+
+	cases := map[string]string{
+		"unknown word":     "alfa bravissimo",
+		"capital digit":    "capital one",
+		"repeated capital": "capital capital alfa",
+		"missing letter":   "alfa capital",
+	}
+	for name, phonetic := range cases {
+		if _, err := ParsePhonetic([]byte(phonetic), NATOAlphabet); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,888 @@
+package atoll
+
+var adjectiveList = [][]byte{
+	[]byte("able"),
+	[]byte("active"),
+	[]byte("afraid"),
+	[]byte("agile"),
+	[]byte("alert"),
+	[]byte("amber"),
+	[]byte("ancient"),
+	[]byte("angry"),
+	[]byte("anxious"),
+	[]byte("arctic"),
+	[]byte("ashen"),
+	[]byte("awake"),
+	[]byte("bald"),
+	[]byte("bare"),
+	[]byte("bashful"),
+	[]byte("bitter"),
+	[]byte("black"),
+	[]byte("bland"),
+	[]byte("blank"),
+	[]byte("bleak"),
+	[]byte("blind"),
+	[]byte("blond"),
+	[]byte("blue"),
+	[]byte("blunt"),
+	[]byte("bold"),
+	[]byte("bored"),
+	[]byte("brave"),
+	[]byte("brief"),
+	[]byte("bright"),
+	[]byte("brisk"),
+	[]byte("broad"),
+	[]byte("bronze"),
+	[]byte("brown"),
+	[]byte("bumpy"),
+	[]byte("busy"),
+	[]byte("calm"),
+	[]byte("candid"),
+	[]byte("careful"),
+	[]byte("cheap"),
+	[]byte("cheerful"),
+	[]byte("chilly"),
+	[]byte("civil"),
+	[]byte("clean"),
+	[]byte("clever"),
+	[]byte("cloudy"),
+	[]byte("clumsy"),
+	[]byte("coarse"),
+	[]byte("cold"),
+	[]byte("cosmic"),
+	[]byte("cozy"),
+	[]byte("crafty"),
+	[]byte("crazy"),
+	[]byte("crimson"),
+	[]byte("crisp"),
+	[]byte("cruel"),
+	[]byte("curious"),
+	[]byte("curly"),
+	[]byte("cute"),
+	[]byte("damp"),
+	[]byte("dapper"),
+	[]byte("daring"),
+	[]byte("dark"),
+	[]byte("dear"),
+	[]byte("deep"),
+	[]byte("dense"),
+	[]byte("dizzy"),
+	[]byte("dry"),
+	[]byte("dull"),
+	[]byte("dusty"),
+	[]byte("eager"),
+	[]byte("early"),
+	[]byte("earnest"),
+	[]byte("easy"),
+	[]byte("elder"),
+	[]byte("electric"),
+	[]byte("elegant"),
+	[]byte("empty"),
+	[]byte("endless"),
+	[]byte("epic"),
+	[]byte("equal"),
+	[]byte("even"),
+	[]byte("exotic"),
+	[]byte("faint"),
+	[]byte("fair"),
+	[]byte("faithful"),
+	[]byte("famous"),
+	[]byte("fancy"),
+	[]byte("fast"),
+	[]byte("fearless"),
+	[]byte("feeble"),
+	[]byte("fierce"),
+	[]byte("fine"),
+	[]byte("firm"),
+	[]byte("flat"),
+	[]byte("fluffy"),
+	[]byte("fond"),
+	[]byte("foolish"),
+	[]byte("fragile"),
+	[]byte("frail"),
+	[]byte("frank"),
+	[]byte("free"),
+	[]byte("fresh"),
+	[]byte("friendly"),
+	[]byte("frosty"),
+	[]byte("frozen"),
+	[]byte("funny"),
+	[]byte("fuzzy"),
+	[]byte("gentle"),
+	[]byte("giant"),
+	[]byte("giddy"),
+	[]byte("glad"),
+	[]byte("gloomy"),
+	[]byte("glossy"),
+	[]byte("golden"),
+	[]byte("good"),
+	[]byte("graceful"),
+	[]byte("grand"),
+	[]byte("grateful"),
+	[]byte("gray"),
+	[]byte("greasy"),
+	[]byte("great"),
+	[]byte("green"),
+	[]byte("grim"),
+	[]byte("grumpy"),
+	[]byte("hairy"),
+	[]byte("handy"),
+	[]byte("happy"),
+	[]byte("hardy"),
+	[]byte("harsh"),
+	[]byte("hasty"),
+	[]byte("heavy"),
+	[]byte("hidden"),
+	[]byte("hollow"),
+	[]byte("holy"),
+	[]byte("honest"),
+	[]byte("hot"),
+	[]byte("huge"),
+	[]byte("humble"),
+	[]byte("hungry"),
+	[]byte("icy"),
+	[]byte("idle"),
+	[]byte("jolly"),
+	[]byte("joyful"),
+	[]byte("juicy"),
+	[]byte("keen"),
+	[]byte("kind"),
+	[]byte("large"),
+	[]byte("late"),
+	[]byte("lazy"),
+	[]byte("lean"),
+	[]byte("little"),
+	[]byte("lively"),
+	[]byte("lonely"),
+	[]byte("long"),
+	[]byte("loose"),
+	[]byte("loud"),
+	[]byte("lovely"),
+	[]byte("loyal"),
+	[]byte("lucky"),
+	[]byte("lunar"),
+	[]byte("mad"),
+	[]byte("magic"),
+	[]byte("major"),
+	[]byte("mellow"),
+	[]byte("merry"),
+	[]byte("mighty"),
+	[]byte("mild"),
+	[]byte("modern"),
+	[]byte("modest"),
+	[]byte("moody"),
+	[]byte("muddy"),
+	[]byte("narrow"),
+	[]byte("nasty"),
+	[]byte("neat"),
+	[]byte("nervous"),
+	[]byte("nimble"),
+	[]byte("noble"),
+	[]byte("noisy"),
+	[]byte("odd"),
+	[]byte("old"),
+	[]byte("olive"),
+	[]byte("orange"),
+	[]byte("pale"),
+	[]byte("patient"),
+	[]byte("peaceful"),
+	[]byte("perfect"),
+	[]byte("pink"),
+	[]byte("plain"),
+	[]byte("playful"),
+	[]byte("pleasant"),
+	[]byte("plump"),
+	[]byte("polite"),
+	[]byte("poor"),
+	[]byte("pretty"),
+	[]byte("proud"),
+	[]byte("purple"),
+	[]byte("quick"),
+	[]byte("quiet"),
+	[]byte("rapid"),
+	[]byte("rare"),
+	[]byte("raw"),
+	[]byte("ready"),
+	[]byte("red"),
+	[]byte("regal"),
+	[]byte("rich"),
+	[]byte("rigid"),
+	[]byte("ripe"),
+	[]byte("rosy"),
+	[]byte("rough"),
+	[]byte("round"),
+	[]byte("royal"),
+	[]byte("rude"),
+	[]byte("rusty"),
+	[]byte("sad"),
+	[]byte("safe"),
+	[]byte("salty"),
+	[]byte("sandy"),
+	[]byte("scared"),
+	[]byte("secret"),
+	[]byte("shaggy"),
+	[]byte("sharp"),
+	[]byte("shiny"),
+	[]byte("short"),
+	[]byte("shy"),
+	[]byte("silent"),
+	[]byte("silky"),
+	[]byte("silly"),
+	[]byte("silver"),
+	[]byte("simple"),
+	[]byte("sleek"),
+	[]byte("sleepy"),
+	[]byte("slim"),
+	[]byte("slow"),
+	[]byte("small"),
+	[]byte("smart"),
+	[]byte("smooth"),
+	[]byte("snowy"),
+	[]byte("soft"),
+	[]byte("solar"),
+	[]byte("solid"),
+	[]byte("sour"),
+	[]byte("spicy"),
+	[]byte("splendid"),
+	[]byte("steady"),
+	[]byte("steep"),
+	[]byte("sticky"),
+	[]byte("stiff"),
+	[]byte("stormy"),
+	[]byte("strange"),
+	[]byte("strict"),
+	[]byte("strong"),
+	[]byte("sturdy"),
+	[]byte("subtle"),
+	[]byte("sunny"),
+	[]byte("super"),
+	[]byte("sweet"),
+	[]byte("swift"),
+	[]byte("tall"),
+	[]byte("tame"),
+	[]byte("tender"),
+	[]byte("thick"),
+	[]byte("thin"),
+	[]byte("thirsty"),
+	[]byte("tidy"),
+	[]byte("tiny"),
+	[]byte("tired"),
+	[]byte("tough"),
+	[]byte("tricky"),
+	[]byte("true"),
+	[]byte("ugly"),
+	[]byte("upset"),
+	[]byte("urban"),
+	[]byte("vast"),
+	[]byte("violet"),
+	[]byte("vivid"),
+	[]byte("wacky"),
+	[]byte("warm"),
+	[]byte("weary"),
+	[]byte("wet"),
+	[]byte("white"),
+	[]byte("wicked"),
+	[]byte("wide"),
+	[]byte("wild"),
+	[]byte("windy"),
+	[]byte("wise"),
+	[]byte("witty"),
+	[]byte("wooden"),
+	[]byte("young"),
+	[]byte("zany"),
+	[]byte("zealous"),
+}
+
+var nounList = [][]byte{
+	[]byte("actors"),
+	[]byte("adults"),
+	[]byte("aliens"),
+	[]byte("anchors"),
+	[]byte("angels"),
+	[]byte("ants"),
+	[]byte("apples"),
+	[]byte("apricots"),
+	[]byte("artists"),
+	[]byte("astronauts"),
+	[]byte("babies"),
+	[]byte("badgers"),
+	[]byte("bakers"),
+	[]byte("balloons"),
+	[]byte("bananas"),
+	[]byte("bandits"),
+	[]byte("barbers"),
+	[]byte("bats"),
+	[]byte("beans"),
+	[]byte("bears"),
+	[]byte("beavers"),
+	[]byte("bees"),
+	[]byte("beetles"),
+	[]byte("bells"),
+	[]byte("birds"),
+	[]byte("bishops"),
+	[]byte("boats"),
+	[]byte("bones"),
+	[]byte("books"),
+	[]byte("boots"),
+	[]byte("bottles"),
+	[]byte("boxers"),
+	[]byte("boys"),
+	[]byte("bricks"),
+	[]byte("brides"),
+	[]byte("brothers"),
+	[]byte("buckets"),
+	[]byte("buffalo"),
+	[]byte("bugs"),
+	[]byte("bulls"),
+	[]byte("bunnies"),
+	[]byte("butchers"),
+	[]byte("buttons"),
+	[]byte("cabins"),
+	[]byte("cakes"),
+	[]byte("camels"),
+	[]byte("candles"),
+	[]byte("captains"),
+	[]byte("carrots"),
+	[]byte("cars"),
+	[]byte("castles"),
+	[]byte("cats"),
+	[]byte("cattle"),
+	[]byte("chairs"),
+	[]byte("cheetahs"),
+	[]byte("chefs"),
+	[]byte("cherries"),
+	[]byte("chickens"),
+	[]byte("children"),
+	[]byte("chipmunks"),
+	[]byte("clocks"),
+	[]byte("clouds"),
+	[]byte("clowns"),
+	[]byte("coins"),
+	[]byte("comets"),
+	[]byte("cooks"),
+	[]byte("cows"),
+	[]byte("coyotes"),
+	[]byte("crabs"),
+	[]byte("cranes"),
+	[]byte("crickets"),
+	[]byte("crows"),
+	[]byte("cups"),
+	[]byte("dancers"),
+	[]byte("deer"),
+	[]byte("dentists"),
+	[]byte("diamonds"),
+	[]byte("doctors"),
+	[]byte("dogs"),
+	[]byte("dolphins"),
+	[]byte("donkeys"),
+	[]byte("doves"),
+	[]byte("dragons"),
+	[]byte("drums"),
+	[]byte("ducks"),
+	[]byte("dwarves"),
+	[]byte("eagles"),
+	[]byte("eels"),
+	[]byte("elephants"),
+	[]byte("elves"),
+	[]byte("engines"),
+	[]byte("falcons"),
+	[]byte("farmers"),
+	[]byte("ferrets"),
+	[]byte("fiddlers"),
+	[]byte("fish"),
+	[]byte("flamingos"),
+	[]byte("flowers"),
+	[]byte("foxes"),
+	[]byte("frogs"),
+	[]byte("gardeners"),
+	[]byte("geese"),
+	[]byte("ghosts"),
+	[]byte("giraffes"),
+	[]byte("girls"),
+	[]byte("goats"),
+	[]byte("gophers"),
+	[]byte("grapes"),
+	[]byte("guitars"),
+	[]byte("hamsters"),
+	[]byte("hawks"),
+	[]byte("hedgehogs"),
+	[]byte("heroes"),
+	[]byte("herons"),
+	[]byte("hippos"),
+	[]byte("horses"),
+	[]byte("hunters"),
+	[]byte("jackals"),
+	[]byte("jaguars"),
+	[]byte("jellyfish"),
+	[]byte("judges"),
+	[]byte("kangaroos"),
+	[]byte("kings"),
+	[]byte("kittens"),
+	[]byte("knights"),
+	[]byte("koalas"),
+	[]byte("ladders"),
+	[]byte("lambs"),
+	[]byte("lemons"),
+	[]byte("lemurs"),
+	[]byte("leopards"),
+	[]byte("lions"),
+	[]byte("lizards"),
+	[]byte("llamas"),
+	[]byte("lobsters"),
+	[]byte("magpies"),
+	[]byte("mammoths"),
+	[]byte("mangos"),
+	[]byte("mermaids"),
+	[]byte("mice"),
+	[]byte("miners"),
+	[]byte("monkeys"),
+	[]byte("monks"),
+	[]byte("moose"),
+	[]byte("moths"),
+	[]byte("mules"),
+	[]byte("nurses"),
+	[]byte("oceans"),
+	[]byte("octopi"),
+	[]byte("onions"),
+	[]byte("otters"),
+	[]byte("owls"),
+	[]byte("oxen"),
+	[]byte("oysters"),
+	[]byte("painters"),
+	[]byte("pandas"),
+	[]byte("panthers"),
+	[]byte("parrots"),
+	[]byte("peaches"),
+	[]byte("pears"),
+	[]byte("pebbles"),
+	[]byte("pelicans"),
+	[]byte("penguins"),
+	[]byte("pianos"),
+	[]byte("pigeons"),
+	[]byte("pigs"),
+	[]byte("pilots"),
+	[]byte("pirates"),
+	[]byte("planets"),
+	[]byte("plums"),
+	[]byte("poets"),
+	[]byte("ponies"),
+	[]byte("poodles"),
+	[]byte("potatoes"),
+	[]byte("priests"),
+	[]byte("puffins"),
+	[]byte("pumpkins"),
+	[]byte("puppies"),
+	[]byte("queens"),
+	[]byte("rabbits"),
+	[]byte("raccoons"),
+	[]byte("radios"),
+	[]byte("rats"),
+	[]byte("ravens"),
+	[]byte("riders"),
+	[]byte("rivers"),
+	[]byte("robins"),
+	[]byte("robots"),
+	[]byte("rockets"),
+	[]byte("sailors"),
+	[]byte("salmon"),
+	[]byte("scouts"),
+	[]byte("seals"),
+	[]byte("sharks"),
+	[]byte("sheep"),
+	[]byte("shrimp"),
+	[]byte("singers"),
+	[]byte("skunks"),
+	[]byte("sloths"),
+	[]byte("snails"),
+	[]byte("snakes"),
+	[]byte("soldiers"),
+	[]byte("sparrows"),
+	[]byte("spiders"),
+	[]byte("squids"),
+	[]byte("squirrels"),
+	[]byte("stars"),
+	[]byte("storks"),
+	[]byte("students"),
+	[]byte("swans"),
+	[]byte("tailors"),
+	[]byte("teachers"),
+	[]byte("tigers"),
+	[]byte("toads"),
+	[]byte("tomatoes"),
+	[]byte("tourists"),
+	[]byte("trains"),
+	[]byte("trees"),
+	[]byte("trolls"),
+	[]byte("trucks"),
+	[]byte("tulips"),
+	[]byte("turkeys"),
+	[]byte("turtles"),
+	[]byte("unicorns"),
+	[]byte("vikings"),
+	[]byte("violins"),
+	[]byte("vultures"),
+	[]byte("waiters"),
+	[]byte("walruses"),
+	[]byte("weasels"),
+	[]byte("whales"),
+	[]byte("wizards"),
+	[]byte("wolves"),
+	[]byte("wombats"),
+	[]byte("workers"),
+	[]byte("worms"),
+	[]byte("yaks"),
+	[]byte("zebras"),
+}
+
+var verbList = [][]byte{
+	[]byte("argue"),
+	[]byte("bake"),
+	[]byte("bark"),
+	[]byte("bathe"),
+	[]byte("blink"),
+	[]byte("bloom"),
+	[]byte("blush"),
+	[]byte("boast"),
+	[]byte("bounce"),
+	[]byte("bow"),
+	[]byte("breathe"),
+	[]byte("chant"),
+	[]byte("chat"),
+	[]byte("cheer"),
+	[]byte("chuckle"),
+	[]byte("clap"),
+	[]byte("climb"),
+	[]byte("cough"),
+	[]byte("crawl"),
+	[]byte("cry"),
+	[]byte("dance"),
+	[]byte("dash"),
+	[]byte("dive"),
+	[]byte("doze"),
+	[]byte("dream"),
+	[]byte("drift"),
+	[]byte("drink"),
+	[]byte("drip"),
+	[]byte("drool"),
+	[]byte("eat"),
+	[]byte("explode"),
+	[]byte("fade"),
+	[]byte("fall"),
+	[]byte("fight"),
+	[]byte("float"),
+	[]byte("flow"),
+	[]byte("fly"),
+	[]byte("fold"),
+	[]byte("freeze"),
+	[]byte("frown"),
+	[]byte("gallop"),
+	[]byte("gasp"),
+	[]byte("giggle"),
+	[]byte("glide"),
+	[]byte("glow"),
+	[]byte("gossip"),
+	[]byte("grin"),
+	[]byte("groan"),
+	[]byte("grow"),
+	[]byte("growl"),
+	[]byte("grumble"),
+	[]byte("grunt"),
+	[]byte("hide"),
+	[]byte("hike"),
+	[]byte("hop"),
+	[]byte("howl"),
+	[]byte("hum"),
+	[]byte("hunt"),
+	[]byte("hurry"),
+	[]byte("itch"),
+	[]byte("jog"),
+	[]byte("juggle"),
+	[]byte("jump"),
+	[]byte("kneel"),
+	[]byte("knit"),
+	[]byte("laugh"),
+	[]byte("leap"),
+	[]byte("linger"),
+	[]byte("listen"),
+	[]byte("march"),
+	[]byte("melt"),
+	[]byte("mingle"),
+	[]byte("moan"),
+	[]byte("mumble"),
+	[]byte("nap"),
+	[]byte("nod"),
+	[]byte("pace"),
+	[]byte("paint"),
+	[]byte("panic"),
+	[]byte("pause"),
+	[]byte("peek"),
+	[]byte("play"),
+	[]byte("ponder"),
+	[]byte("pout"),
+	[]byte("pray"),
+	[]byte("prowl"),
+	[]byte("purr"),
+	[]byte("quack"),
+	[]byte("quarrel"),
+	[]byte("race"),
+	[]byte("relax"),
+	[]byte("rest"),
+	[]byte("roam"),
+	[]byte("roar"),
+	[]byte("rot"),
+	[]byte("run"),
+	[]byte("rush"),
+	[]byte("scream"),
+	[]byte("shiver"),
+	[]byte("shout"),
+	[]byte("shrug"),
+	[]byte("sigh"),
+	[]byte("sing"),
+	[]byte("sink"),
+	[]byte("sit"),
+	[]byte("skate"),
+	[]byte("ski"),
+	[]byte("skip"),
+	[]byte("sleep"),
+	[]byte("slide"),
+	[]byte("slip"),
+	[]byte("smile"),
+	[]byte("sneeze"),
+	[]byte("snore"),
+	[]byte("sob"),
+	[]byte("sparkle"),
+	[]byte("speak"),
+	[]byte("spin"),
+	[]byte("sprint"),
+	[]byte("squeak"),
+	[]byte("stagger"),
+	[]byte("stare"),
+	[]byte("stomp"),
+	[]byte("stroll"),
+	[]byte("struggle"),
+	[]byte("study"),
+	[]byte("sulk"),
+	[]byte("swim"),
+	[]byte("swing"),
+	[]byte("talk"),
+	[]byte("tremble"),
+	[]byte("trot"),
+	[]byte("twirl"),
+	[]byte("vanish"),
+	[]byte("wait"),
+	[]byte("waltz"),
+	[]byte("wander"),
+	[]byte("weep"),
+	[]byte("whine"),
+	[]byte("whisper"),
+	[]byte("whistle"),
+	[]byte("wiggle"),
+	[]byte("win"),
+	[]byte("wink"),
+	[]byte("wobble"),
+	[]byte("work"),
+	[]byte("worry"),
+	[]byte("yawn"),
+	[]byte("yell"),
+	[]byte("yodel"),
+}
+
+var adverbList = [][]byte{
+	[]byte("abruptly"),
+	[]byte("absently"),
+	[]byte("accidentally"),
+	[]byte("adoringly"),
+	[]byte("almost"),
+	[]byte("always"),
+	[]byte("angrily"),
+	[]byte("anxiously"),
+	[]byte("awkwardly"),
+	[]byte("badly"),
+	[]byte("barely"),
+	[]byte("bashfully"),
+	[]byte("beautifully"),
+	[]byte("blindly"),
+	[]byte("boldly"),
+	[]byte("bravely"),
+	[]byte("briefly"),
+	[]byte("brightly"),
+	[]byte("briskly"),
+	[]byte("broadly"),
+	[]byte("busily"),
+	[]byte("calmly"),
+	[]byte("carefully"),
+	[]byte("carelessly"),
+	[]byte("cautiously"),
+	[]byte("cheerfully"),
+	[]byte("clearly"),
+	[]byte("cleverly"),
+	[]byte("closely"),
+	[]byte("clumsily"),
+	[]byte("correctly"),
+	[]byte("crazily"),
+	[]byte("curiously"),
+	[]byte("daily"),
+	[]byte("daintily"),
+	[]byte("dearly"),
+	[]byte("deeply"),
+	[]byte("defiantly"),
+	[]byte("deliberately"),
+	[]byte("eagerly"),
+	[]byte("easily"),
+	[]byte("elegantly"),
+	[]byte("enormously"),
+	[]byte("equally"),
+	[]byte("eventually"),
+	[]byte("exactly"),
+	[]byte("faithfully"),
+	[]byte("famously"),
+	[]byte("fiercely"),
+	[]byte("finally"),
+	[]byte("fondly"),
+	[]byte("foolishly"),
+	[]byte("frankly"),
+	[]byte("freely"),
+	[]byte("gently"),
+	[]byte("gladly"),
+	[]byte("gleefully"),
+	[]byte("gracefully"),
+	[]byte("gratefully"),
+	[]byte("greatly"),
+	[]byte("greedily"),
+	[]byte("happily"),
+	[]byte("hastily"),
+	[]byte("heavily"),
+	[]byte("helpfully"),
+	[]byte("honestly"),
+	[]byte("hopelessly"),
+	[]byte("hourly"),
+	[]byte("hungrily"),
+	[]byte("innocently"),
+	[]byte("instantly"),
+	[]byte("intensely"),
+	[]byte("jealously"),
+	[]byte("jovially"),
+	[]byte("joyfully"),
+	[]byte("kindly"),
+	[]byte("knowingly"),
+	[]byte("lazily"),
+	[]byte("lightly"),
+	[]byte("loosely"),
+	[]byte("loudly"),
+	[]byte("lovingly"),
+	[]byte("loyally"),
+	[]byte("madly"),
+	[]byte("merrily"),
+	[]byte("mightily"),
+	[]byte("mostly"),
+	[]byte("mysteriously"),
+	[]byte("nearly"),
+	[]byte("neatly"),
+	[]byte("nervously"),
+	[]byte("never"),
+	[]byte("nightly"),
+	[]byte("noisily"),
+	[]byte("often"),
+	[]byte("openly"),
+	[]byte("patiently"),
+	[]byte("perfectly"),
+	[]byte("playfully"),
+	[]byte("politely"),
+	[]byte("poorly"),
+	[]byte("positively"),
+	[]byte("powerfully"),
+	[]byte("promptly"),
+	[]byte("proudly"),
+	[]byte("quickly"),
+	[]byte("quietly"),
+	[]byte("rapidly"),
+	[]byte("rarely"),
+	[]byte("readily"),
+	[]byte("really"),
+	[]byte("recklessly"),
+	[]byte("regularly"),
+	[]byte("reluctantly"),
+	[]byte("repeatedly"),
+	[]byte("rudely"),
+	[]byte("sadly"),
+	[]byte("safely"),
+	[]byte("seldom"),
+	[]byte("selfishly"),
+	[]byte("seriously"),
+	[]byte("sharply"),
+	[]byte("shyly"),
+	[]byte("silently"),
+	[]byte("sleepily"),
+	[]byte("slowly"),
+	[]byte("smoothly"),
+	[]byte("softly"),
+	[]byte("solemnly"),
+	[]byte("sometimes"),
+	[]byte("soon"),
+	[]byte("speedily"),
+	[]byte("steadily"),
+	[]byte("sternly"),
+	[]byte("strictly"),
+	[]byte("strongly"),
+	[]byte("suddenly"),
+	[]byte("surprisingly"),
+	[]byte("sweetly"),
+	[]byte("swiftly"),
+	[]byte("tenderly"),
+	[]byte("tensely"),
+	[]byte("thankfully"),
+	[]byte("tightly"),
+	[]byte("today"),
+	[]byte("tomorrow"),
+	[]byte("truly"),
+	[]byte("unexpectedly"),
+	[]byte("upward"),
+	[]byte("urgently"),
+	[]byte("usefully"),
+	[]byte("vaguely"),
+	[]byte("vainly"),
+	[]byte("valiantly"),
+	[]byte("victoriously"),
+	[]byte("violently"),
+	[]byte("warmly"),
+	[]byte("weakly"),
+	[]byte("wearily"),
+	[]byte("well"),
+	[]byte("wildly"),
+	[]byte("wisely"),
+	[]byte("wonderfully"),
+	[]byte("yearly"),
+	[]byte("yesterday"),
+	[]byte("zealously"),
+}
+
+var numeralList = [][]byte{
+	[]byte("eight"),
+	[]byte("eighteen"),
+	[]byte("eighty"),
+	[]byte("eleven"),
+	[]byte("fifteen"),
+	[]byte("fifty"),
+	[]byte("five"),
+	[]byte("forty"),
+	[]byte("four"),
+	[]byte("fourteen"),
+	[]byte("hundred"),
+	[]byte("nine"),
+	[]byte("nineteen"),
+	[]byte("ninety"),
+	[]byte("seven"),
+	[]byte("seventeen"),
+	[]byte("seventy"),
+	[]byte("six"),
+	[]byte("sixteen"),
+	[]byte("sixty"),
+	[]byte("ten"),
+	[]byte("thirteen"),
+	[]byte("thirty"),
+	[]byte("thousand"),
+	[]byte("three"),
+	[]byte("twelve"),
+	[]byte("twenty"),
+	[]byte("two"),
+}
@@ -0,0 +1,182 @@
+package atoll
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+var (
+	defaultOnsets = []string{
+		"b", "c", "d", "f", "g", "h", "j", "k", "l", "m", "n", "p", "r", "s", "t", "v", "w", "z",
+		"bl", "br", "cl", "cr", "dr", "fl", "fr", "gl", "gr", "pl", "pr", "sl", "sm", "sn", "sp", "st", "sw", "tr",
+	}
+	defaultCodas = []string{"b", "d", "g", "k", "l", "m", "n", "p", "r", "s", "t"}
+)
+
+// Pronounceable is a word source that generates pronounceable words made of syllables with the
+// shapes CV, CVC and CCV, where C is a consonant (or an allowed consonant cluster) and V a vowel.
+//
+// Words have the structure onset, vowel, (medial, vowel)..., coda, where the medial clusters
+// are either an onset or a coda followed by a single consonant onset, and the final coda is optional.
+// Medial clusters are deduplicated so every word can be produced in only one way, which makes the
+// entropy reported exact.
+type Pronounceable struct {
+	// Consonants and consonant clusters syllables can start with. Single consonants
+	// are used by default, plus clusters like "bl", "st" and "tr".
+	Onsets []string
+	// Consonants syllables can end with.
+	Codas []string
+	// Minimum and maximum number of syllables of each word, 2 and 3 by default.
+	MinSyllables uint64
+	MaxSyllables uint64
+}
+
+// Word returns a random pronounceable word.
+func (pr *Pronounceable) Word(int) []byte {
+	onsets, medials, codas := pr.clusters()
+	min, max := pr.syllables()
+	n := min + int(randInt(max-min+1))
+
+	var word []byte
+	word = append(word, onsets[randInt(len(onsets))]...)
+	word = append(word, vowels[randInt(len(vowels))]...)
+	for i := 1; i < n; i++ {
+		word = append(word, medials[randInt(len(medials))]...)
+		word = append(word, vowels[randInt(len(vowels))]...)
+	}
+	// The last element represents the absence of a coda
+	if i := randInt(len(codas) + 1); int(i) < len(codas) {
+		word = append(word, codas[i]...)
+	}
+
+	return word
+}
+
+// Entropy returns the entropy in bits of a word.
+func (pr *Pronounceable) Entropy(int) float64 {
+	onsets, medials, codas := pr.clusters()
+	min, max := pr.syllables()
+	counts := float64(max - min + 1)
+
+	// Each syllables count is equally likely and can be recognized from the word (it's the number
+	// of vowels), so the entropy is the one of the count plus the mean of the entropy given the count
+	entropy := math.Log2(counts) + math.Log2(float64(len(onsets))) + math.Log2(float64(len(codas)+1))
+	for n := min; n <= max; n++ {
+		syllableEntropy := float64(n)*math.Log2(float64(len(vowels))) + float64(n-1)*math.Log2(float64(len(medials)))
+		entropy += syllableEntropy / counts
+	}
+
+	return entropy
+}
+
+// meanLetters returns the expected number of letters of a word.
+func (pr *Pronounceable) meanLetters(int) float64 {
+	onsets, medials, codas := pr.clusters()
+	min, max := pr.syllables()
+
+	letters := meanLength(onsets) + meanLength(codas)*float64(len(codas))/float64(len(codas)+1)
+	for n := min; n <= max; n++ {
+		letters += (float64(n) + float64(n-1)*meanLength(medials)) / float64(max-min+1)
+	}
+
+	return letters
+}
+
+// wordCount returns the number of different words generated.
+func (pr *Pronounceable) wordCount() float64 {
+	onsets, medials, codas := pr.clusters()
+	min, max := pr.syllables()
+
+	var count float64
+	for n := min; n <= max; n++ {
+		count += math.Pow(float64(len(vowels)), float64(n)) * math.Pow(float64(len(medials)), float64(n-1))
+	}
+	return count * float64(len(onsets)) * float64(len(codas)+1)
+}
+
+// validate checks that the clusters are made only of consonants and the syllables range is valid.
+func (pr *Pronounceable) validate() error {
+	if pr.MaxSyllables != 0 && pr.MinSyllables > pr.MaxSyllables {
+		return errors.New("minimum syllables is higher than the maximum syllables")
+	}
+
+	for _, cluster := range append(slices.Clone(pr.Onsets), pr.Codas...) {
+		if cluster == "" {
+			return errors.New("empty consonant clusters aren't allowed")
+		}
+		for _, c := range cluster {
+			if !strings.ContainsRune(string(Lower), c) || slices.Contains(vowels[:], string(c)) {
+				return fmt.Errorf("cluster %q contains characters that aren't lowercase consonants", cluster)
+			}
+		}
+	}
+
+	return nil
+}
+
+// syllables returns the range of the number of syllables.
+func (pr *Pronounceable) syllables() (min, max int) {
+	min, max = 2, 3
+	if pr.MinSyllables != 0 {
+		min = int(pr.MinSyllables)
+	}
+	if pr.MaxSyllables != 0 {
+		max = int(pr.MaxSyllables)
+	}
+
+	if min > max {
+		// Only the minimum was set
+		max = min
+	}
+
+	return min, max
+}
+
+// clusters returns the deduplicated onsets, medial clusters and codas.
+func (pr *Pronounceable) clusters() (onsets, medials, codas []string) {
+	onsets = pr.Onsets
+	if len(onsets) == 0 {
+		onsets = defaultOnsets
+	}
+	codas = pr.Codas
+	if len(codas) == 0 {
+		codas = defaultCodas
+	}
+	onsets, codas = dedup(onsets), dedup(codas)
+
+	medials = slices.Clone(onsets)
+	for _, coda := range codas {
+		for _, onset := range onsets {
+			if len(onset) == 1 {
+				medials = append(medials, coda+onset)
+			}
+		}
+	}
+
+	return onsets, dedup(medials), codas
+}
+
+// dedup returns the unique elements of s, keeping their order.
+func dedup(s []string) []string {
+	seen := make(map[string]struct{}, len(s))
+	unique := make([]string, 0, len(s))
+	for _, e := range s {
+		if _, ok := seen[e]; !ok {
+			seen[e] = struct{}{}
+			unique = append(unique, e)
+		}
+	}
+	return unique
+}
+
+// meanLength returns the mean length of the strings.
+func meanLength(s []string) float64 {
+	total := 0
+	for _, e := range s {
+		total += len(e)
+	}
+	return float64(total) / float64(len(s))
+}
@@ -0,0 +1,116 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestPronounceable(t *testing.T) {
+	pr := &Pronounceable{MinSyllables: 2, MaxSyllables: 4}
+
+	for i := 0; i < 200; i++ {
+		word := pr.Word(i)
+
+		syllables := 0
+		for _, c := range word {
+			if strings.ContainsRune("aeiou", rune(c)) {
+				syllables++
+			}
+		}
+		if syllables < 2 || syllables > 4 {
+			t.Errorf("Expected 2 to 4 syllables, got %d: %q", syllables, word)
+		}
+
+		// Consonant clusters are at most three letters long
+		clusters := bytes.FieldsFunc(word, func(r rune) bool { return strings.ContainsRune("aeiou", r) })
+		for _, cluster := range clusters {
+			if len(cluster) > 3 {
+				t.Errorf("Unpronounceable cluster %q in %q", cluster, word)
+			}
+		}
+	}
+}
+
+func TestPronounceableEntropy(t *testing.T) {
+	pr := &Pronounceable{
+		Onsets:       []string{"s", "t", "st"},
+		Codas:        []string{"s"},
+		MinSyllables: 2,
+		MaxSyllables: 2,
+	}
+
+	// Enumerate every possible word to verify that each of them can be produced in only one way
+	onsets, medials, codas := pr.clusters()
+	words := make(map[string]struct{})
+	total := 0
+	for _, o := range onsets {
+		for _, v1 := range vowels {
+			for _, m := range medials {
+				for _, v2 := range vowels {
+					for _, c := range append([]string{""}, codas...) {
+						words[o+v1+m+v2+c] = struct{}{}
+						total++
+					}
+				}
+			}
+		}
+	}
+
+	if len(words) != total {
+		t.Fatalf("Expected %d different words, got %d", total, len(words))
+	}
+
+	expected := math.Log2(float64(total))
+	if got := pr.Entropy(0); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	var letters int
+	for w := range words {
+		letters += len(w)
+	}
+	expected = float64(letters) / float64(total)
+	if got := pr.meanLetters(0); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f mean letters, got %f", expected, got)
+	}
+}
+
+func TestPronounceablePassphrase(t *testing.T) {
+	pr := &Pronounceable{}
+	p := &Passphrase{
+		Length:    5,
+		Separator: "-",
+		Source:    pr,
+		Case:      RandomLetterCase,
+		Unique:    true,
+	}
+
+	passphrase, err := p.Generate()
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	words := bytes.Split(passphrase, []byte(p.Separator))
+	if len(words) != int(p.Length) {
+		t.Errorf("Expected %d words, got %d", p.Length, len(words))
+	}
+
+	expected := 5 * (pr.Entropy(0) + pr.meanLetters(0))
+	if got := p.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	invalid := map[string]*Pronounceable{
+		"vowel in onset":    {Onsets: []string{"ba"}},
+		"empty coda":        {Codas: []string{""}},
+		"uppercase":         {Codas: []string{"B"}},
+		"invalid syllables": {MinSyllables: 4, MaxSyllables: 3},
+	}
+	for k, tc := range invalid {
+		if _, err := (&Passphrase{Length: 2, Source: tc}).Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", k)
+		}
+	}
+}
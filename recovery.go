@@ -0,0 +1,136 @@
+package atoll
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RecoveryCodes represents a set of single-use codes that let users regain access to their account
+// when they lose their second factor: 4Z9KQ-7WM3X.
+//
+// Codes use the Crockford Base32 alphabet, so verification is case-insensitive and tolerates
+// delimiters, white space and typos like O instead of 0. Only salted hashes should be stored.
+type RecoveryCodes struct {
+	// Number of codes of the set, 10 by default.
+	Count uint64
+	// Number of symbols of each code, 10 by default.
+	Length uint64
+	// Number of symbols of each group, 5 by default.
+	GroupSize uint64
+	// PBKDF2-SHA256 iterations used to hash the codes, 100000 by default. Codes are random so they
+	// don't need the work factor recommended for passwords.
+	Iterations uint64
+}
+
+// Generate returns a set of unique random codes to show to the user and their hashes in PHC string
+// format to store.
+func (r *RecoveryCodes) Generate() (codes [][]byte, hashes []string, err error) {
+	codes, hashes, err = r.generate()
+	if err != nil {
+		return nil, nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return codes, hashes, nil
+}
+
+func (r *RecoveryCodes) generate() ([][]byte, []string, error) {
+	count, code, iterations := r.params()
+	if err := r.validateParams(code); err != nil {
+		return nil, nil, err
+	}
+
+	codes := make([][]byte, 0, count)
+	hashes := make([]string, 0, count)
+	seen := make(map[string]struct{}, count)
+	for len(codes) < count {
+		c, err := code.Generate()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		normalized := code.Normalize(c)
+		if _, ok := seen[string(normalized)]; ok {
+			continue
+		}
+		seen[string(normalized)] = struct{}{}
+
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, c)
+		hashes = append(hashes, hashPBKDF2(normalized, salt, iterations))
+	}
+
+	return codes, hashes, nil
+}
+
+// Entropy returns the entropy in bits of each code.
+func (r *RecoveryCodes) Entropy() float64 {
+	_, code, _ := r.params()
+	return code.Entropy()
+}
+
+// Verify returns the index of the hash that matches the code, so the caller can mark it as used.
+//
+// The code is normalized before hashing and every hash is checked, comparing them in constant time,
+// so the time taken doesn't reveal which one matched.
+func (r *RecoveryCodes) Verify(code []byte, hashes []string) (int, error) {
+	_, c, _ := r.params()
+	if err := r.validateParams(c); err != nil {
+		return -1, fmt.Errorf("atoll: %w", err)
+	}
+
+	normalized := c.Normalize(code)
+	match := -1
+	for i, h := range hashes {
+		ok, err := verifyPBKDF2(normalized, h)
+		if err != nil {
+			return -1, fmt.Errorf("atoll: hash %d: %w", i, err)
+		}
+		if ok && match == -1 {
+			match = i
+		}
+	}
+
+	if match == -1 {
+		return -1, errors.New("atoll: invalid recovery code")
+	}
+	return match, nil
+}
+
+// params returns the number of codes, the code format and the iterations with their defaults.
+func (r *RecoveryCodes) params() (count int, code *Code, iterations int) {
+	count, iterations = 10, 100000
+	code = &Code{Length: 10, GroupSize: 5}
+	if r.Count != 0 {
+		count = int(r.Count)
+	}
+	if r.Length != 0 {
+		code.Length = r.Length
+	}
+	if r.GroupSize != 0 {
+		code.GroupSize = r.GroupSize
+	}
+	if r.Iterations != 0 {
+		iterations = int(r.Iterations)
+	}
+
+	return count, code, iterations
+}
+
+func (r *RecoveryCodes) validateParams(code *Code) error {
+	if err := code.validateParams(); err != nil {
+		return err
+	}
+
+	// Leave enough room for the codes to be unique
+	count, _, _ := r.params()
+	if math.Log2(float64(count)) > code.Entropy()/2 {
+		return fmt.Errorf("codes of %d symbols are too short for a set of %d", code.Length, count)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRecoveryCodes(t *testing.T) {
+	r := &RecoveryCodes{Iterations: 10}
+	codes, hashes, err := r.Generate()
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	if len(codes) != 10 || len(hashes) != 10 {
+		t.Fatalf("Expected 10 codes and hashes, got %d and %d", len(codes), len(hashes))
+	}
+
+	seen := make(map[string]struct{}, len(codes))
+	for i, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf("Expected a code like XXXXX-XXXXX, got %q", code)
+		}
+		if strings.Trim(string(bytes.ReplaceAll(code, []byte("-"), nil)), Crockford) != "" {
+			t.Errorf("Code %q contains invalid symbols", code)
+		}
+		if _, ok := seen[string(code)]; ok {
+			t.Errorf("Code %q is repeated", code)
+		}
+		seen[string(code)] = struct{}{}
+
+		if !strings.HasPrefix(hashes[i], "$pbkdf2-sha256$i=10$") {
+			t.Errorf("Invalid hash %q", hashes[i])
+		}
+		if strings.Contains(hashes[i], string(code[:5])) {
+			t.Errorf("Hash %q contains the code", hashes[i])
+		}
+
+		idx, err := r.Verify(code, hashes)
+		if err != nil {
+			t.Errorf("Verify(%q) failed: %v", code, err)
+		}
+		if idx != i {
+			t.Errorf("Expected index %d, got %d", i, idx)
+		}
+	}
+}
+
+func TestRecoveryCodesVerify(t *testing.T) {
+	r := &RecoveryCodes{Count: 3, Iterations: 10}
+	salt := []byte("0123456789abcdef")
+	hashes := []string{
+		hashPBKDF2([]byte("ABCDE12345"), salt, 10),
+		hashPBKDF2([]byte("0V1AB99999"), salt, 10),
+		hashPBKDF2([]byte("ZZZZZ00000"), salt, 10),
+	}
+
+	cases := []struct {
+		code     string
+		expected int
+	}{
+		{code: "ABCDE-12345", expected: 0},
+		{code: "abcde12345", expected: 0},
+		{code: " abcde 12345\n", expected: 0},
+		{code: "ouiab-99999", expected: 1},
+		{code: "ZZZZZ-OOOOO", expected: 2},
+		{code: "ZZZZZ-00001", expected: -1},
+		{code: "", expected: -1},
+	}
+
+	for _, tc := range cases {
+		got, err := r.Verify([]byte(tc.code), hashes)
+		if tc.expected == -1 {
+			if err == nil {
+				t.Errorf("Expected %q to be invalid", tc.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Verify(%q) failed: %v", tc.code, err)
+		}
+		if got != tc.expected {
+			t.Errorf("%q: expected %d, got %d", tc.code, tc.expected, got)
+		}
+	}
+
+	if _, err := r.Verify([]byte("ABCDE-12345"), []string{"invalid"}); err == nil {
+		t.Error("Expected hash error, got nil")
+	}
+}
+
+func TestRecoveryCodesEntropy(t *testing.T) {
+	r := &RecoveryCodes{Length: 12}
+	expected := 12 * math.Log2(32)
+	if got := r.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestInvalidRecoveryCodes(t *testing.T) {
+	cases := map[string]*RecoveryCodes{
+		"too short": {Count: 1000, Length: 2},
+	}
+
+	for name, r := range cases {
+		if _, _, err := r.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+		if _, err := r.Verify([]byte("AB"), nil); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package atoll
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// PartOfSpeech is the grammatical category of the words that fill a slot of a sentence template.
+type PartOfSpeech uint8
+
+// Parts of speech, the words are chosen so that a template like "adjective noun verb adverb"
+// produces a sentence: "brave foxes dance quietly".
+const (
+	// Adjective like "brave".
+	Adjective PartOfSpeech = iota + 1
+	// Noun in plural like "foxes".
+	Noun
+	// Verb in its base form like "dance".
+	Verb
+	// Adverb like "quietly".
+	Adverb
+	// Numeral greater than one like "ten".
+	Numeral
+)
+
+var partOfSpeechNames = map[PartOfSpeech]string{
+	Adjective: "adjective",
+	Noun:      "noun",
+	Verb:      "verb",
+	Adverb:    "adverb",
+	Numeral:   "numeral",
+}
+
+// String returns the name of the part of speech.
+func (pos PartOfSpeech) String() string {
+	if name, ok := partOfSpeechNames[pos]; ok {
+		return name
+	}
+	return fmt.Sprintf("PartOfSpeech(%d)", uint8(pos))
+}
+
+// list returns the words tagged with the part of speech.
+func (pos PartOfSpeech) list() [][]byte {
+	switch pos {
+	case Adjective:
+		return adjectiveList
+	case Noun:
+		return nounList
+	case Verb:
+		return verbList
+	case Adverb:
+		return adverbList
+	case Numeral:
+		return numeralList
+	default:
+		return nil
+	}
+}
+
+// ParseTemplate returns the template described by the names of its parts of speech separated by
+// white space, like "adjective noun verb adverb".
+func ParseTemplate(template string) ([]PartOfSpeech, error) {
+	fields := strings.Fields(template)
+	if len(fields) == 0 {
+		return nil, errors.New("atoll: empty template")
+	}
+
+	parts := make([]PartOfSpeech, 0, len(fields))
+	for _, field := range fields {
+		var part PartOfSpeech
+		for pos, name := range partOfSpeechNames {
+			if strings.EqualFold(field, name) {
+				part = pos
+				break
+			}
+		}
+		if part == 0 {
+			return nil, fmt.Errorf("atoll: unknown part of speech %q", field)
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// validateTemplate checks that the template is valid and compatible with the rest of the parameters.
+func (p *Passphrase) validateTemplate() error {
+	if len(p.Template) == 0 {
+		return nil
+	}
+
+	if p.Source != nil {
+		return errors.New("template cannot be combined with a word source")
+	}
+
+	if len(p.Include) != 0 {
+		return errors.New("words cannot be included in a template")
+	}
+
+	if p.MaxLength != 0 || (p.Length != 0 && p.Length != uint64(len(p.Template))) {
+		return fmt.Errorf("passphrase length does not match the %d words of the template", len(p.Template))
+	}
+
+	for _, pos := range p.Template {
+		if pos.list() == nil {
+			return fmt.Errorf("invalid part of speech: %d", pos)
+		}
+	}
+
+	return nil
+}
+
+// templateEntropy returns the entropy of a passphrase that fills the template, the number of possible
+// passphrases is the product of the number of words available for each slot.
+func (p *Passphrase) templateEntropy() float64 {
+	var entropy float64
+	used := make(map[PartOfSpeech]int)
+	for i, pos := range p.Template {
+		source := p.slotSource(i)
+		available := p.available(source)
+		if p.Unique {
+			// Parts of speech don't share words, only the previous slots of the same one matter
+			available -= used[pos]
+			used[pos]++
+		}
+		entropy += math.Log2(float64(available))
+
+		switch p.Case {
+		case RandomWordCase:
+			entropy++
+		case RandomLetterCase:
+			var letters int
+			for _, word := range source {
+				letters += letterCount(word)
+			}
+			entropy += float64(letters) / float64(len(source))
+		}
+	}
+
+	// Separators aren't included in the secret length
+	length := uint64(len(p.Template))
+	return entropy + p.paddingEntropy(length) + p.separatorsEntropy(length)
+}
@@ -0,0 +1,125 @@
+package atoll
+
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// SentencePassword represents a short password derived from a random sentence that is used to
+// remember it: "Ten purple elephants dance quietly at 3pm" becomes "Tpedqa3p".
+//
+// The password is made of the first letter of every run of letters of the sentence plus its
+// digits and symbols. Its entropy is the one of the passwords derived, not the one of the sentence,
+// as many sentences lead to the same password: each word adds only about 4 bits (2.4 a numeral).
+// The default template, two clauses of ten words, gives passwords of about 39.6 bits, 44.2 with
+// Time. Shorter templates are easier to remember but weak, use them only for low-value accounts.
+type SentencePassword struct {
+	// Parts of speech of the sentence, Numeral Adjective Noun Verb Adverb Adjective Adjective Noun
+	// Verb Adverb by default.
+	Template []PartOfSpeech
+	// Append a random time to the sentence ("at 3pm"), which adds digits to the password.
+	Time bool
+}
+
+// Generate generates a random sentence password.
+func (sp *SentencePassword) Generate() ([]byte, error) {
+	sentence, password, err := sp.GenerateSentence()
+	if err != nil {
+		return nil, err
+	}
+
+	// Wipe sensitive data
+	for i := range sentence {
+		sentence[i] = 0
+	}
+	// Keep sentence alive so preceding loop is not optimized out
+	runtime.KeepAlive(sentence)
+	return password, nil
+}
+
+// GenerateSentence generates a random sentence and the password derived from it.
+func (sp *SentencePassword) GenerateSentence() (sentence, password []byte, err error) {
+	p := &Passphrase{Template: sp.template(), Separator: " "}
+	sentence, err = p.Generate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The sentence starts with an uppercase letter
+	sentence[0] = upper(sentence[0])
+	if sp.Time {
+		hour := randInt(12) + 1
+		suffix := "am"
+		if randInt(2) == 1 {
+			suffix = "pm"
+		}
+		sentence = append(sentence, " at "+strconv.Itoa(int(hour))+suffix...)
+	}
+
+	return sentence, deriveSentencePassword(sentence), nil
+}
+
+// Entropy returns the sentence password entropy in bits.
+func (sp *SentencePassword) Entropy() float64 {
+	// Every word contributes its first letter only, so the entropy of each slot is the one of
+	// the distribution of the first letters of its list
+	var entropy float64
+	for _, pos := range sp.template() {
+		list := pos.list()
+		if list == nil {
+			return 0
+		}
+
+		counts := make(map[byte]int)
+		for _, word := range list {
+			counts[word[0]]++
+		}
+		for _, count := range counts {
+			prob := float64(count) / float64(len(list))
+			entropy -= prob * math.Log2(prob)
+		}
+	}
+
+	if sp.Time {
+		// "at" is always the same, the hour and the first letter of am/pm are 24 combinations
+		entropy += math.Log2(24)
+	}
+
+	return entropy
+}
+
+// template returns the template used or the default one.
+func (sp *SentencePassword) template() []PartOfSpeech {
+	if len(sp.Template) == 0 {
+		return []PartOfSpeech{
+			Numeral, Adjective, Noun, Verb, Adverb,
+			Adjective, Adjective, Noun, Verb, Adverb,
+		}
+	}
+	return sp.Template
+}
+
+// deriveSentencePassword returns the first letter of every run of letters of the sentence
+// along with its digits and symbols.
+func deriveSentencePassword(sentence []byte) []byte {
+	password := make([]byte, 0, len(sentence)/4)
+	inWord := false
+	for _, c := range sentence {
+		switch {
+		case strings.ContainsRune(string(Lower+Upper), rune(c)):
+			if !inWord {
+				password = append(password, c)
+			}
+			inWord = true
+		case c == ' ':
+			inWord = false
+		default:
+			password = append(password, c)
+			inWord = false
+		}
+	}
+
+	return password
+}
@@ -0,0 +1,121 @@
+package atoll
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSentencePassword(t *testing.T) {
+	cases := []*SentencePassword{
+		{},
+		{Time: true},
+		{Template: []PartOfSpeech{Adjective, Adjective, Noun, Verb}, Time: true},
+	}
+
+	for _, sp := range cases {
+		for i := 0; i < 50; i++ {
+			sentence, password, err := sp.GenerateSentence()
+			if err != nil {
+				t.Fatalf("GenerateSentence() failed: %v", err)
+			}
+
+			words := strings.Fields(string(sentence))
+			expectedWords := len(sp.template())
+			if sp.Time {
+				expectedWords += 2
+			}
+			if len(words) != expectedWords {
+				t.Fatalf("Expected %d words, got %q", expectedWords, sentence)
+			}
+
+			if !isUpper(rune(sentence[0])) || !isUpper(rune(password[0])) {
+				t.Errorf("Expected sentence and password to start with an uppercase letter, got %q and %q", sentence, password)
+			}
+
+			expectedLength := len(sp.template())
+			if sp.Time {
+				// "a", the hour and "a" or "p"
+				expectedLength += 2 + len(strings.TrimRight(words[len(words)-1], "apm"))
+			}
+			if len(password) != expectedLength {
+				t.Errorf("Expected a password of %d characters, got %q (%q)", expectedLength, password, sentence)
+			}
+			for j, pos := range sp.template() {
+				if lower(password[j]) != words[j][0] && password[j] != words[j][0] {
+					t.Errorf("Expected %q to start with %q", words[j], password[j])
+				}
+				if !slices.ContainsFunc(pos.list(), equalBytes([]byte(strings.ToLower(words[j])))) {
+					t.Errorf("%q is not a %s", words[j], pos)
+				}
+			}
+		}
+	}
+}
+
+func TestDeriveSentencePassword(t *testing.T) {
+	cases := map[string]string{
+		"Ten purple elephants dance quietly at 3pm": "Tpedqa3p",
+		"Meet me at 10:30, ok?":                     "Mma10:30,o?",
+		"one-way":                                   "o-w",
+		"":                                          "",
+	}
+
+	for sentence, expected := range cases {
+		if got := string(deriveSentencePassword([]byte(sentence))); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+}
+
+func TestSentencePasswordEntropy(t *testing.T) {
+	sp := &SentencePassword{Template: []PartOfSpeech{Numeral}}
+
+	// Count the numerals by their first letter
+	counts := make(map[byte]float64)
+	for _, word := range numeralList {
+		counts[word[0]]++
+	}
+	var expected float64
+	for _, count := range counts {
+		p := count / float64(len(numeralList))
+		expected -= p * math.Log2(p)
+	}
+
+	if got := sp.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	sp.Time = true
+	expected += math.Log2(24)
+	if got := sp.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	// The entropy of the password is lower than the one of the sentence
+	sp = &SentencePassword{}
+	p := &Passphrase{Template: sp.template()}
+	if sp.Entropy() >= p.Entropy() {
+		t.Errorf("Expected the password entropy (%f) to be lower than the sentence one (%f)", sp.Entropy(), p.Entropy())
+	}
+}
+
+func TestSentencePasswordDefaultEntropy(t *testing.T) {
+	// The default template must not produce weak passwords if the lists change
+	const minEntropy = 39
+	sp := &SentencePassword{}
+	if got := sp.Entropy(); got < minEntropy {
+		t.Errorf("Expected the default entropy to be at least %d bits, got %f", minEntropy, got)
+	}
+}
+
+func TestInvalidSentencePassword(t *testing.T) {
+	sp := &SentencePassword{Template: []PartOfSpeech{Noun, 0}}
+	if _, err := sp.Generate(); err == nil {
+		t.Error("Expected invalid part of speech error, got nil")
+	}
+	if sp.Entropy() != 0 {
+		t.Errorf("Expected 0, got %f", sp.Entropy())
+	}
+}
@@ -0,0 +1,142 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSentence(t *testing.T) {
+	template, err := ParseTemplate("adjective noun verb adverb")
+	if err != nil {
+		t.Fatalf("ParseTemplate() failed: %v", err)
+	}
+
+	cases := map[string]*Passphrase{
+		"default":     {Template: template},
+		"unique":      {Template: []PartOfSpeech{Adjective, Adjective, Noun, Verb}, Unique: true},
+		"max chars":   {Template: template, Separator: "-", MaxChars: 24},
+		"word length": {Template: template, MinWordLength: 4, MaxWordLength: 6, Length: 4},
+	}
+
+	for name, p := range cases {
+		for i := 0; i < 50; i++ {
+			passphrase, err := p.Generate()
+			if err != nil {
+				t.Fatalf("%s: Generate() failed: %v", name, err)
+			}
+
+			words := bytes.Split(passphrase, []byte(p.Separator))
+			if len(words) != len(p.Template) {
+				t.Fatalf("%s: expected %d words, got %q", name, len(p.Template), passphrase)
+			}
+			for j, word := range words {
+				if !slices.ContainsFunc(p.Template[j].list(), equalBytes(word)) {
+					t.Errorf("%s: %q is not a %s", name, word, p.Template[j])
+				}
+			}
+
+			if p.Unique && bytes.Equal(words[0], words[1]) {
+				t.Errorf("%s: repeated word in %q", name, passphrase)
+			}
+			if p.MaxChars != 0 && len(passphrase) > int(p.MaxChars) {
+				t.Errorf("%s: expected at most %d characters, got %q", name, p.MaxChars, passphrase)
+			}
+			if p.MaxWordLength != 0 {
+				for _, word := range words {
+					if len(word) < 4 || len(word) > 6 {
+						t.Errorf("%s: %q is out of the word length limits", name, word)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTemplateEntropy(t *testing.T) {
+	adjectives := float64(len(adjectiveList))
+	nouns := float64(len(nounList))
+
+	cases := []struct {
+		p        *Passphrase
+		expected float64
+	}{
+		{
+			p:        &Passphrase{Template: []PartOfSpeech{Adjective, Noun}},
+			expected: math.Log2(adjectives * nouns),
+		},
+		{
+			p:        &Passphrase{Template: []PartOfSpeech{Noun, Adjective, Noun}, Unique: true},
+			expected: math.Log2(nouns * adjectives * (nouns - 1)),
+		},
+		{
+			p:        &Passphrase{Template: []PartOfSpeech{Noun, Noun}, Exclude: []string{"Cats", "notaword"}},
+			expected: 2 * math.Log2(nouns-1),
+		},
+		{
+			p:        &Passphrase{Template: []PartOfSpeech{Adjective, Noun}, Case: RandomWordCase},
+			expected: math.Log2(adjectives*nouns) + 2,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := tc.p.Entropy(); math.Abs(got-tc.expected) > 1e-9 {
+			t.Errorf("Expected %f, got %f", tc.expected, got)
+		}
+	}
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	template := []PartOfSpeech{Adjective, Noun}
+	cases := map[string]*Passphrase{
+		"word source":     {Template: template, Source: &Pronounceable{}},
+		"include":         {Template: template, Include: []string{"atoll"}},
+		"length mismatch": {Template: template, Length: 3},
+		"length range":    {Template: template, MinLength: 2, MaxLength: 3},
+		"part of speech":  {Template: []PartOfSpeech{Noun, 9}},
+		"not enough":      {Template: []PartOfSpeech{Noun, Noun}, MinWordLength: 11, Unique: true},
+		"no words":        {Template: template, MinWordLength: 30},
+		"max chars":       {Template: template, MaxChars: 4},
+	}
+
+	for name, p := range cases {
+		if _, err := p.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
+
+func TestParseTemplate(t *testing.T) {
+	template, err := ParseTemplate(" Adjective  NOUN verb\tadverb noun ")
+	if err != nil {
+		t.Fatalf("ParseTemplate() failed: %v", err)
+	}
+
+	expected := []PartOfSpeech{Adjective, Noun, Verb, Adverb, Noun}
+	if !slices.Equal(template, expected) {
+		t.Errorf("Expected %v, got %v", expected, template)
+	}
+
+	for _, s := range []string{"", "adjective pronoun"} {
+		if _, err := ParseTemplate(s); err == nil {
+			t.Errorf("Expected %q to fail", s)
+		}
+	}
+}
+
+func TestPartOfSpeechLists(t *testing.T) {
+	seen := make(map[string]PartOfSpeech)
+	for pos := Adjective; pos <= Numeral; pos++ {
+		for _, word := range pos.list() {
+			if strings.Trim(string(word), string(Lower)) != "" {
+				t.Errorf("Invalid %s %q", pos, word)
+			}
+			if other, ok := seen[string(word)]; ok {
+				t.Errorf("%q is both a %s and a %s", word, other, pos)
+			}
+			seen[string(word)] = pos
+		}
+	}
+}
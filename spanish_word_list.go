@@ -0,0 +1,410 @@
+package atoll
+
+var spanishWordList = [][]byte{
+	[]byte("abajo"),
+	[]byte("abeja"),
+	[]byte("abierto"),
+	[]byte("abrazo"),
+	[]byte("abrigo"),
+	[]byte("abril"),
+	[]byte("abuela"),
+	[]byte("abuelo"),
+	[]byte("acabar"),
+	[]byte("aceite"),
+	[]byte("acero"),
+	[]byte("agua"),
+	[]byte("aguila"),
+	[]byte("ahora"),
+	[]byte("aire"),
+	[]byte("ajedrez"),
+	[]byte("alegre"),
+	[]byte("algodon"),
+	[]byte("alma"),
+	[]byte("almohada"),
+	[]byte("alto"),
+	[]byte("alumno"),
+	[]byte("amable"),
+	[]byte("amarillo"),
+	[]byte("amigo"),
+	[]byte("amor"),
+	[]byte("ancho"),
+	[]byte("andar"),
+	[]byte("angel"),
+	[]byte("anillo"),
+	[]byte("animal"),
+	[]byte("antes"),
+	[]byte("antiguo"),
+	[]byte("anzuelo"),
+	[]byte("apagar"),
+	[]byte("aprender"),
+	[]byte("arbol"),
+	[]byte("arena"),
+	[]byte("armario"),
+	[]byte("arroz"),
+	[]byte("arte"),
+	[]byte("asiento"),
+	[]byte("atras"),
+	[]byte("aula"),
+	[]byte("avena"),
+	[]byte("avion"),
+	[]byte("ayer"),
+	[]byte("ayuda"),
+	[]byte("azucar"),
+	[]byte("azul"),
+	[]byte("bailar"),
+	[]byte("bajo"),
+	[]byte("ballena"),
+	[]byte("banco"),
+	[]byte("bandera"),
+	[]byte("barco"),
+	[]byte("barrio"),
+	[]byte("basura"),
+	[]byte("batalla"),
+	[]byte("baul"),
+	[]byte("bebida"),
+	[]byte("belleza"),
+	[]byte("beso"),
+	[]byte("biblioteca"),
+	[]byte("bicicleta"),
+	[]byte("blanco"),
+	[]byte("blando"),
+	[]byte("boca"),
+	[]byte("bolsa"),
+	[]byte("bosque"),
+	[]byte("bota"),
+	[]byte("botella"),
+	[]byte("brazo"),
+	[]byte("brillo"),
+	[]byte("broma"),
+	[]byte("bueno"),
+	[]byte("burro"),
+	[]byte("buscar"),
+	[]byte("caballo"),
+	[]byte("cabeza"),
+	[]byte("cable"),
+	[]byte("cabra"),
+	[]byte("cadena"),
+	[]byte("caja"),
+	[]byte("calabaza"),
+	[]byte("calcetin"),
+	[]byte("caliente"),
+	[]byte("calle"),
+	[]byte("calor"),
+	[]byte("cama"),
+	[]byte("camino"),
+	[]byte("camisa"),
+	[]byte("campana"),
+	[]byte("campo"),
+	[]byte("canasta"),
+	[]byte("cancion"),
+	[]byte("cantar"),
+	[]byte("capa"),
+	[]byte("cara"),
+	[]byte("caracol"),
+	[]byte("carne"),
+	[]byte("carta"),
+	[]byte("casa"),
+	[]byte("castillo"),
+	[]byte("cebolla"),
+	[]byte("cena"),
+	[]byte("cepillo"),
+	[]byte("cerca"),
+	[]byte("cerdo"),
+	[]byte("cereza"),
+	[]byte("cero"),
+	[]byte("cielo"),
+	[]byte("ciudad"),
+	[]byte("claro"),
+	[]byte("clavo"),
+	[]byte("coche"),
+	[]byte("cocina"),
+	[]byte("codo"),
+	[]byte("cohete"),
+	[]byte("colina"),
+	[]byte("collar"),
+	[]byte("color"),
+	[]byte("comer"),
+	[]byte("cometa"),
+	[]byte("comida"),
+	[]byte("conejo"),
+	[]byte("copa"),
+	[]byte("corazon"),
+	[]byte("cordero"),
+	[]byte("correr"),
+	[]byte("cosa"),
+	[]byte("costa"),
+	[]byte("crecer"),
+	[]byte("cuaderno"),
+	[]byte("cuchara"),
+	[]byte("cuchillo"),
+	[]byte("cuello"),
+	[]byte("cuento"),
+	[]byte("cuerda"),
+	[]byte("cuerpo"),
+	[]byte("cueva"),
+	[]byte("cuidar"),
+	[]byte("dedo"),
+	[]byte("delfin"),
+	[]byte("dentro"),
+	[]byte("derecho"),
+	[]byte("desierto"),
+	[]byte("despacio"),
+	[]byte("dia"),
+	[]byte("diente"),
+	[]byte("dinero"),
+	[]byte("domingo"),
+	[]byte("dormir"),
+	[]byte("dragon"),
+	[]byte("ducha"),
+	[]byte("dulce"),
+	[]byte("duro"),
+	[]byte("edad"),
+	[]byte("elefante"),
+	[]byte("enano"),
+	[]byte("enero"),
+	[]byte("entrada"),
+	[]byte("escalera"),
+	[]byte("escoba"),
+	[]byte("escuela"),
+	[]byte("espada"),
+	[]byte("espejo"),
+	[]byte("esponja"),
+	[]byte("estrella"),
+	[]byte("estufa"),
+	[]byte("falda"),
+	[]byte("familia"),
+	[]byte("farol"),
+	[]byte("feliz"),
+	[]byte("fiesta"),
+	[]byte("flecha"),
+	[]byte("flor"),
+	[]byte("foca"),
+	[]byte("fresa"),
+	[]byte("frio"),
+	[]byte("fruta"),
+	[]byte("fuego"),
+	[]byte("fuente"),
+	[]byte("fuerte"),
+	[]byte("galleta"),
+	[]byte("gallina"),
+	[]byte("ganso"),
+	[]byte("garaje"),
+	[]byte("gato"),
+	[]byte("gigante"),
+	[]byte("globo"),
+	[]byte("gorra"),
+	[]byte("gota"),
+	[]byte("grande"),
+	[]byte("granja"),
+	[]byte("grillo"),
+	[]byte("grito"),
+	[]byte("guante"),
+	[]byte("guerra"),
+	[]byte("guitarra"),
+	[]byte("gusano"),
+	[]byte("hacha"),
+	[]byte("hada"),
+	[]byte("harina"),
+	[]byte("helado"),
+	[]byte("hermano"),
+	[]byte("hielo"),
+	[]byte("hierba"),
+	[]byte("hierro"),
+	[]byte("higo"),
+	[]byte("hijo"),
+	[]byte("hilo"),
+	[]byte("hoja"),
+	[]byte("hombre"),
+	[]byte("hormiga"),
+	[]byte("horno"),
+	[]byte("huevo"),
+	[]byte("humo"),
+	[]byte("idioma"),
+	[]byte("iglesia"),
+	[]byte("isla"),
+	[]byte("jardin"),
+	[]byte("jarra"),
+	[]byte("jaula"),
+	[]byte("jinete"),
+	[]byte("joven"),
+	[]byte("joya"),
+	[]byte("juego"),
+	[]byte("jueves"),
+	[]byte("jugar"),
+	[]byte("juguete"),
+	[]byte("jungla"),
+	[]byte("labio"),
+	[]byte("ladrillo"),
+	[]byte("lago"),
+	[]byte("lagrima"),
+	[]byte("lampara"),
+	[]byte("lana"),
+	[]byte("lapiz"),
+	[]byte("largo"),
+	[]byte("lata"),
+	[]byte("lavar"),
+	[]byte("leche"),
+	[]byte("lechuga"),
+	[]byte("leer"),
+	[]byte("lejos"),
+	[]byte("lengua"),
+	[]byte("leon"),
+	[]byte("letra"),
+	[]byte("libro"),
+	[]byte("limon"),
+	[]byte("linea"),
+	[]byte("lluvia"),
+	[]byte("lobo"),
+	[]byte("loro"),
+	[]byte("luna"),
+	[]byte("lunes"),
+	[]byte("luz"),
+	[]byte("madera"),
+	[]byte("madre"),
+	[]byte("maleta"),
+	[]byte("mano"),
+	[]byte("manzana"),
+	[]byte("mapa"),
+	[]byte("mar"),
+	[]byte("mariposa"),
+	[]byte("martes"),
+	[]byte("martillo"),
+	[]byte("mesa"),
+	[]byte("miel"),
+	[]byte("minuto"),
+	[]byte("mirar"),
+	[]byte("mochila"),
+	[]byte("moneda"),
+	[]byte("mono"),
+	[]byte("montana"),
+	[]byte("morado"),
+	[]byte("mosca"),
+	[]byte("muela"),
+	[]byte("mujer"),
+	[]byte("mundo"),
+	[]byte("muneca"),
+	[]byte("musica"),
+	[]byte("nada"),
+	[]byte("naranja"),
+	[]byte("nariz"),
+	[]byte("nave"),
+	[]byte("negro"),
+	[]byte("nido"),
+	[]byte("nieve"),
+	[]byte("nino"),
+	[]byte("noche"),
+	[]byte("nombre"),
+	[]byte("norte"),
+	[]byte("nube"),
+	[]byte("nuevo"),
+	[]byte("nuez"),
+	[]byte("numero"),
+	[]byte("ola"),
+	[]byte("oliva"),
+	[]byte("olla"),
+	[]byte("oro"),
+	[]byte("oso"),
+	[]byte("otono"),
+	[]byte("oveja"),
+	[]byte("padre"),
+	[]byte("pagina"),
+	[]byte("pais"),
+	[]byte("pajaro"),
+	[]byte("palabra"),
+	[]byte("palacio"),
+	[]byte("paloma"),
+	[]byte("pan"),
+	[]byte("panuelo"),
+	[]byte("papel"),
+	[]byte("paraguas"),
+	[]byte("pared"),
+	[]byte("parque"),
+	[]byte("pasillo"),
+	[]byte("pastel"),
+	[]byte("pato"),
+	[]byte("payaso"),
+	[]byte("peine"),
+	[]byte("pelota"),
+	[]byte("pensar"),
+	[]byte("pepino"),
+	[]byte("pera"),
+	[]byte("perro"),
+	[]byte("pescado"),
+	[]byte("pez"),
+	[]byte("piano"),
+	[]byte("pie"),
+	[]byte("piedra"),
+	[]byte("pierna"),
+	[]byte("pimienta"),
+	[]byte("pino"),
+	[]byte("pintura"),
+	[]byte("piscina"),
+	[]byte("planta"),
+	[]byte("plato"),
+	[]byte("playa"),
+	[]byte("pluma"),
+	[]byte("pollo"),
+	[]byte("puente"),
+	[]byte("puerta"),
+	[]byte("pulpo"),
+	[]byte("queso"),
+	[]byte("rana"),
+	[]byte("raton"),
+	[]byte("regalo"),
+	[]byte("reina"),
+	[]byte("reloj"),
+	[]byte("rey"),
+	[]byte("rio"),
+	[]byte("roca"),
+	[]byte("rodilla"),
+	[]byte("rojo"),
+	[]byte("ropa"),
+	[]byte("rosa"),
+	[]byte("rueda"),
+	[]byte("sabado"),
+	[]byte("sal"),
+	[]byte("salir"),
+	[]byte("salud"),
+	[]byte("sandia"),
+	[]byte("semana"),
+	[]byte("semilla"),
+	[]byte("serpiente"),
+	[]byte("silla"),
+	[]byte("sol"),
+	[]byte("sombrero"),
+	[]byte("sopa"),
+	[]byte("suelo"),
+	[]byte("sueno"),
+	[]byte("tarde"),
+	[]byte("taza"),
+	[]byte("techo"),
+	[]byte("telefono"),
+	[]byte("tierra"),
+	[]byte("tigre"),
+	[]byte("tijeras"),
+	[]byte("tomate"),
+	[]byte("tortuga"),
+	[]byte("trabajo"),
+	[]byte("tren"),
+	[]byte("trigo"),
+	[]byte("trueno"),
+	[]byte("tubo"),
+	[]byte("uva"),
+	[]byte("vaca"),
+	[]byte("valle"),
+	[]byte("vaso"),
+	[]byte("vela"),
+	[]byte("ventana"),
+	[]byte("verano"),
+	[]byte("verde"),
+	[]byte("vestido"),
+	[]byte("viaje"),
+	[]byte("viento"),
+	[]byte("viernes"),
+	[]byte("vino"),
+	[]byte("violin"),
+	[]byte("volcan"),
+	[]byte("yegua"),
+	[]byte("zapato"),
+	[]byte("zorro"),
+}
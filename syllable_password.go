@@ -0,0 +1,166 @@
+package atoll
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// syllableSet holds the three letter syllables of the syllable list and the unique two letter
+// prefixes and suffixes they have.
+type syllableSet struct {
+	syllables [][]byte
+	prefixes  [][]byte
+	suffixes  [][]byte
+}
+
+var threeLetterSyllables = sync.OnceValue(func() *syllableSet {
+	set := &syllableSet{}
+	prefixes := make(map[string]struct{})
+	suffixes := make(map[string]struct{})
+	for _, s := range syllableList {
+		if len(s) != 3 {
+			continue
+		}
+		set.syllables = append(set.syllables, s)
+		if _, ok := prefixes[string(s[:2])]; !ok {
+			prefixes[string(s[:2])] = struct{}{}
+			set.prefixes = append(set.prefixes, s[:2])
+		}
+		if _, ok := suffixes[string(s[1:])]; !ok {
+			suffixes[string(s[1:])] = struct{}{}
+			set.suffixes = append(set.suffixes, s[1:])
+		}
+	}
+	return set
+})
+
+// SyllablePassword represents a password made of groups of three letter syllables with one
+// uppercase letter and one digit, like tobqib-hezfy3-rinjaN. They are easy to read out loud and to
+// type on mobile keyboards.
+//
+// The digit takes the place of the first or the last letter of a random group, and the uppercase
+// letter is chosen randomly among the rest. Every password that can be generated is equally likely.
+type SyllablePassword struct {
+	// Number of groups, 3 by default.
+	Groups uint64
+	// Number of syllables of each group, 2 by default.
+	Syllables uint64
+	// Separator between groups, "-" by default. It cannot contain letters or digits.
+	Separator string
+}
+
+// NewSyllablePassword returns a random syllable password with the given number of groups.
+func NewSyllablePassword(groups uint64) ([]byte, error) {
+	sp := &SyllablePassword{
+		Groups: groups,
+	}
+
+	return sp.Generate()
+}
+
+// Generate generates a random syllable password.
+func (sp *SyllablePassword) Generate() ([]byte, error) {
+	password, err := sp.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return password, nil
+}
+
+func (sp *SyllablePassword) generate() ([]byte, error) {
+	if err := sp.validateParams(); err != nil {
+		return nil, err
+	}
+
+	groups, syllables, sep := sp.params()
+	set := threeLetterSyllables()
+
+	// The digit position and the letters next to it are chosen together so every combination is
+	// equally likely, even though the number of unique prefixes and suffixes differ
+	digitGroup := int(randInt(groups))
+	digitIdx := int(randInt(len(set.suffixes) + len(set.prefixes)))
+	digitFirst := digitIdx < len(set.suffixes)
+
+	password := make([]byte, 0, groups*syllables*3+(groups-1)*len(sep))
+	for g := 0; g < groups; g++ {
+		if g > 0 {
+			password = append(password, sep...)
+		}
+		for s := 0; s < syllables; s++ {
+			switch {
+			case g == digitGroup && digitFirst && s == 0:
+				password = append(password, Digit[randInt(len(Digit))])
+				password = append(password, set.suffixes[digitIdx]...)
+			case g == digitGroup && !digitFirst && s == syllables-1:
+				password = append(password, set.prefixes[digitIdx-len(set.suffixes)]...)
+				password = append(password, Digit[randInt(len(Digit))])
+			default:
+				password = append(password, set.syllables[randInt(len(set.syllables))]...)
+			}
+		}
+	}
+
+	// Convert a random letter to uppercase
+	n := int(randInt(sp.letters()))
+	for i, c := range password {
+		if !strings.ContainsRune(string(Lower), rune(c)) {
+			continue
+		}
+		if n == 0 {
+			password[i] = c - 'a' + 'A'
+			break
+		}
+		n--
+	}
+
+	return password, nil
+}
+
+// Entropy returns the syllable password entropy in bits.
+func (sp *SyllablePassword) Entropy() float64 {
+	groups, syllables, _ := sp.params()
+	set := threeLetterSyllables()
+
+	// Passwords are uniformly distributed, the entropy is the logarithm of their number
+	entropy := math.Log2(float64(groups)) +
+		math.Log2(float64(len(Digit))) +
+		math.Log2(float64(len(set.suffixes)+len(set.prefixes))) +
+		float64(groups*syllables-1)*math.Log2(float64(len(set.syllables))) +
+		math.Log2(float64(sp.letters()))
+
+	return entropy
+}
+
+// letters returns the number of letters of the password.
+func (sp *SyllablePassword) letters() int {
+	groups, syllables, _ := sp.params()
+	return groups*syllables*3 - 1
+}
+
+// params returns the number of groups, syllables per group and the separator with their defaults.
+func (sp *SyllablePassword) params() (groups, syllables int, sep string) {
+	groups, syllables, sep = 3, 2, "-"
+	if sp.Groups != 0 {
+		groups = int(sp.Groups)
+	}
+	if sp.Syllables != 0 {
+		syllables = int(sp.Syllables)
+	}
+	if sp.Separator != "" {
+		sep = sp.Separator
+	}
+
+	return groups, syllables, sep
+}
+
+func (sp *SyllablePassword) validateParams() error {
+	if strings.ContainsAny(sp.Separator, string(Lower+Upper+Digit)) {
+		return errors.New("separator cannot contain letters or digits")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package atoll
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSyllablePassword(t *testing.T) {
+	cases := []*SyllablePassword{
+		{},
+		{Groups: 4, Syllables: 3, Separator: "."},
+		{Groups: 1, Syllables: 1, Separator: "_"},
+	}
+
+	set := threeLetterSyllables()
+	for _, sp := range cases {
+		groups, syllables, sep := sp.params()
+		for i := 0; i < 50; i++ {
+			password, err := sp.Generate()
+			if err != nil {
+				t.Fatalf("Generate() failed: %v", err)
+			}
+
+			if n := bytes.IndexFunc(password, isUpper); n == -1 || bytes.LastIndexFunc(password, isUpper) != n {
+				t.Errorf("Expected exactly one uppercase letter, got %q", password)
+			}
+			if got := digitCount(password); got != 1 {
+				t.Errorf("Expected exactly one digit, got %d: %q", got, password)
+			}
+
+			parts := strings.Split(strings.ToLower(string(password)), sep)
+			if len(parts) != groups {
+				t.Fatalf("Expected %d groups, got %q", groups, password)
+			}
+			for _, part := range parts {
+				if len(part) != syllables*3 {
+					t.Fatalf("Expected groups of %d characters, got %q", syllables*3, password)
+				}
+				for s := 0; s < len(part); s += 3 {
+					syllable := []byte(part[s : s+3])
+					switch {
+					case strings.ContainsRune(string(Digit), rune(syllable[0])):
+						if !slices.ContainsFunc(set.suffixes, equalBytes(syllable[1:])) {
+							t.Errorf("Invalid suffix %q in %q", syllable[1:], password)
+						}
+						if s != 0 {
+							t.Errorf("Digit isn't at the start of the group in %q", password)
+						}
+					case strings.ContainsRune(string(Digit), rune(syllable[2])):
+						if !slices.ContainsFunc(set.prefixes, equalBytes(syllable[:2])) {
+							t.Errorf("Invalid prefix %q in %q", syllable[:2], password)
+						}
+						if s != len(part)-3 {
+							t.Errorf("Digit isn't at the end of the group in %q", password)
+						}
+					case !slices.ContainsFunc(set.syllables, equalBytes(syllable)):
+						t.Errorf("Invalid syllable %q in %q", syllable, password)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSyllablePasswordEntropy(t *testing.T) {
+	set := threeLetterSyllables()
+	sp := &SyllablePassword{Groups: 1, Syllables: 1}
+
+	// Digit first or last, the remaining two letters and the uppercase letter position
+	count := 1 * 10 * float64(len(set.prefixes)+len(set.suffixes)) * 2
+	expected := math.Log2(count)
+	if got := sp.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+
+	sp = &SyllablePassword{}
+	count = 3 * 10 * float64(len(set.prefixes)+len(set.suffixes)) * math.Pow(float64(len(set.syllables)), 5) * 17
+	expected = math.Log2(count)
+	if got := sp.Entropy(); math.Abs(got-expected) > 1e-9 {
+		t.Errorf("Expected %f, got %f", expected, got)
+	}
+}
+
+func TestInvalidSyllablePassword(t *testing.T) {
+	for _, sep := range []string{"a", "-1-", "X"} {
+		sp := &SyllablePassword{Separator: sep}
+		if _, err := sp.Generate(); err == nil {
+			t.Errorf("Expected invalid separator %q error, got nil", sep)
+		}
+	}
+}
+
+func TestNewSyllablePassword(t *testing.T) {
+	password, err := NewSyllablePassword(2)
+	if err != nil {
+		t.Fatalf("NewSyllablePassword() failed: %v", err)
+	}
+
+	if len(password) != 13 {
+		t.Errorf("Expected a password of 13 characters, got %q", password)
+	}
+}
+
+func isUpper(r rune) bool {
+	return strings.ContainsRune(string(Upper), r)
+}
+
+func digitCount(b []byte) int {
+	count := 0
+	for _, c := range b {
+		if strings.ContainsRune(string(Digit), rune(c)) {
+			count++
+		}
+	}
+	return count
+}
+
+func equalBytes(a []byte) func([]byte) bool {
+	return func(b []byte) bool {
+		return bytes.Equal(a, b)
+	}
+}
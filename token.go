@@ -0,0 +1,176 @@
+package atoll
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Base58Alphabet is the Bitcoin base58 alphabet, which excludes 0, O, I and l to avoid confusions.
+const Base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Encoding represents the encoding used to render the random bytes of a token.
+type Encoding uint8
+
+// Token encodings.
+const (
+	// Hex is the lowercase hexadecimal encoding.
+	Hex Encoding = iota
+	// Base32 is the standard base32 encoding (RFC 4648) with padding.
+	Base32
+	// Base32NoPadding is the standard base32 encoding (RFC 4648) without padding.
+	Base32NoPadding
+	// Base58 is the Bitcoin base58 encoding, leading zero bytes are encoded as '1'.
+	Base58
+	// Base62 encodes the bytes as a big-endian number in base62, leading zero bytes are
+	// encoded as '0'.
+	Base62
+	// Base64URL is the URL and filename safe base64 encoding (RFC 4648) without padding.
+	Base64URL
+)
+
+// Token represents a number of random bytes rendered in a standard encoding, useful for session
+// IDs, CSRF tokens, salts and nonces.
+//
+// Unlike Password, every byte is uniformly random so the entropy is exactly the number of bits.
+type Token struct {
+	// Number of random bytes.
+	Bytes uint64
+	// Number of random bits, used instead of Bytes when it's not a multiple of 8. The unused
+	// leading bits of the first byte are zero.
+	Bits uint64
+	// Encoding of the bytes, Hex by default.
+	Encoding Encoding
+}
+
+// NewToken returns a token of n random bytes rendered in the given encoding.
+func NewToken(n uint64, encoding Encoding) ([]byte, error) {
+	t := &Token{
+		Bytes:    n,
+		Encoding: encoding,
+	}
+
+	return t.Generate()
+}
+
+// Generate generates a random token.
+func (t *Token) Generate() ([]byte, error) {
+	token, err := t.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return token, nil
+}
+
+func (t *Token) generate() ([]byte, error) {
+	if err := t.validateParams(); err != nil {
+		return nil, err
+	}
+
+	bits := t.bits()
+	data := make([]byte, (bits+7)/8)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+	data[0] &= byte(0xFF >> (uint64(len(data))*8 - bits))
+
+	token := encodeToken(data, t.Encoding)
+	// Wipe sensitive data
+	for i := range data {
+		data[i] = 0
+	}
+
+	return token, nil
+}
+
+// Entropy returns the token entropy in bits.
+func (t *Token) Entropy() float64 {
+	return float64(t.bits())
+}
+
+// Equal reports whether the secrets a and b are equal in constant time, so comparing a secret
+// against a user supplied value doesn't leak information through timing. Only the length may leak.
+func Equal(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+// bits returns the number of random bits of the token.
+func (t *Token) bits() uint64 {
+	if t.Bits != 0 {
+		return t.Bits
+	}
+	return t.Bytes * 8
+}
+
+func (t *Token) validateParams() error {
+	if t.Bytes != 0 && t.Bits != 0 {
+		return errors.New("token size must be set either in bytes or in bits, not both")
+	}
+	if t.bits() < 1 {
+		return errors.New("token size must be equal to or higher than 1")
+	}
+	if t.Encoding > Base64URL {
+		return fmt.Errorf("invalid encoding: %d", t.Encoding)
+	}
+
+	return nil
+}
+
+// encodeToken returns the data rendered in the encoding.
+func encodeToken(data []byte, encoding Encoding) []byte {
+	var enc interface {
+		EncodedLen(n int) int
+		Encode(dst, src []byte)
+	}
+	switch encoding {
+	case Base32:
+		enc = base32.StdEncoding
+	case Base32NoPadding:
+		enc = base32.StdEncoding.WithPadding(base32.NoPadding)
+	case Base58:
+		return encodeBigEndian(data, Base58Alphabet)
+	case Base62:
+		return encodeBigEndian(data, Base62Alphabet)
+	case Base64URL:
+		enc = base64.RawURLEncoding
+	default:
+		return []byte(hex.EncodeToString(data))
+	}
+
+	dst := make([]byte, enc.EncodedLen(len(data)))
+	enc.Encode(dst, data)
+	return dst
+}
+
+// encodeBigEndian returns the data interpreted as a big-endian number written with the alphabet,
+// each leading zero byte is written as the first character of the alphabet so it isn't lost.
+func encodeBigEndian(data []byte, alphabet string) []byte {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	n := new(big.Int).SetBytes(data)
+	base := big.NewInt(int64(len(alphabet)))
+	mod := new(big.Int)
+	var encoded []byte
+	for n.Sign() > 0 {
+		n.QuoRem(n, base, mod)
+		encoded = append(encoded, alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		encoded = append(encoded, alphabet[0])
+	}
+
+	// Digits were appended from the least significant one
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return encoded
+}
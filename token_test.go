@@ -0,0 +1,144 @@
+package atoll
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestToken(t *testing.T) {
+	cases := []struct {
+		token  *Token
+		length int
+		chars  string
+	}{
+		{token: &Token{Bytes: 16}, length: 32, chars: "0123456789abcdef"},
+		{token: &Token{Bytes: 16, Encoding: Base32}, length: 32, chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567="},
+		{token: &Token{Bytes: 16, Encoding: Base32NoPadding}, length: 26, chars: "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"},
+		{token: &Token{Bytes: 32, Encoding: Base64URL}, length: 43, chars: string(Lower+Upper+Digit) + "-_"},
+		{token: &Token{Bits: 64, Encoding: Hex}, length: 16, chars: "0123456789abcdef"},
+	}
+
+	for _, tc := range cases {
+		token, err := tc.token.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		if len(token) != tc.length {
+			t.Errorf("Expected length to be %d, got %d", tc.length, len(token))
+		}
+		if strings.Trim(string(token), tc.chars) != "" {
+			t.Errorf("Token %q contains invalid characters", token)
+		}
+	}
+}
+
+func TestTokenBits(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		token, err := (&Token{Bits: 12}).Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+		if len(token) != 4 || token[0] != '0' {
+			t.Fatalf("Expected 12 bits token to be 0xxx, got %q", token)
+		}
+	}
+}
+
+func TestTokenEncodings(t *testing.T) {
+	data := []byte("Hello World!")
+	cases := map[Encoding]string{
+		Hex:             hex.EncodeToString(data),
+		Base32:          base32.StdEncoding.EncodeToString(data),
+		Base32NoPadding: base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data),
+		Base58:          "2NEpo7TZRRrLZSi2U",
+		Base64URL:       base64.RawURLEncoding.EncodeToString(data),
+	}
+
+	for encoding, expected := range cases {
+		if got := encodeToken(data, encoding); string(got) != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+}
+
+func TestEncodeBigEndian(t *testing.T) {
+	cases := []struct {
+		data     []byte
+		alphabet string
+		expected string
+	}{
+		{data: []byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}, alphabet: Base58Alphabet, expected: "11233QC4"},
+		{data: []byte{0, 0}, alphabet: Base58Alphabet, expected: "11"},
+		{data: []byte{61}, alphabet: Base62Alphabet, expected: "z"},
+		{data: []byte{62}, alphabet: Base62Alphabet, expected: "10"},
+		{data: []byte{0, 1, 0}, alphabet: Base62Alphabet, expected: "048"},
+	}
+
+	for _, tc := range cases {
+		if got := encodeBigEndian(tc.data, tc.alphabet); string(got) != tc.expected {
+			t.Errorf("Expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestTokenEntropy(t *testing.T) {
+	cases := []struct {
+		token    *Token
+		expected float64
+	}{
+		{token: &Token{Bytes: 32}, expected: 256},
+		{token: &Token{Bits: 100, Encoding: Base58}, expected: 100},
+	}
+
+	for _, tc := range cases {
+		if got := tc.token.Entropy(); got != tc.expected {
+			t.Errorf("Expected %f, got %f", tc.expected, got)
+		}
+	}
+}
+
+func TestInvalidToken(t *testing.T) {
+	cases := map[string]*Token{
+		"empty":            {},
+		"bytes and bits":   {Bytes: 16, Bits: 128},
+		"invalid encoding": {Bytes: 16, Encoding: 10},
+	}
+
+	for name, tc := range cases {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	token, err := NewToken(24, Base62)
+	if err != nil {
+		t.Fatalf("NewToken() failed: %v", err)
+	}
+	if strings.Trim(string(token), Base62Alphabet) != "" || len(token) > 33 {
+		t.Errorf("Invalid base62 token %q", token)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "secret", b: "secret", expected: true},
+		{a: "secret", b: "secreT", expected: false},
+		{a: "secret", b: "secrets", expected: false},
+		{a: "", b: "", expected: true},
+	}
+
+	for _, tc := range cases {
+		if got := Equal([]byte(tc.a), []byte(tc.b)); got != tc.expected {
+			t.Errorf("Equal(%q, %q): expected %t, got %t", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package atoll
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HashAlgorithm represents the hash function used by HMAC based secrets.
+type HashAlgorithm uint8
+
+// Hash algorithms.
+const (
+	// SHA1 is the default algorithm of one-time passwords and the only one supported by many
+	// authenticator apps.
+	SHA1 HashAlgorithm = iota
+	// SHA256 is the SHA-256 hash function.
+	SHA256
+	// SHA512 is the SHA-512 hash function.
+	SHA512
+)
+
+var hashAlgorithmNames = [...]string{SHA1: "SHA1", SHA256: "SHA256", SHA512: "SHA512"}
+
+// String returns the name of the algorithm as used in otpauth URIs.
+func (h HashAlgorithm) String() string {
+	if int(h) >= len(hashAlgorithmNames) {
+		return "HashAlgorithm(" + strconv.Itoa(int(h)) + ")"
+	}
+	return hashAlgorithmNames[h]
+}
+
+// new returns the hash constructor of the algorithm.
+func (h HashAlgorithm) new() func() hash.Hash {
+	switch h {
+	case SHA256:
+		return sha256.New
+	case SHA512:
+		return sha512.New
+	default:
+		return sha1.New
+	}
+}
+
+// maxTOTPSkew is the maximum number of periods accepted before and after the current one.
+const maxTOTPSkew = 10
+
+// otpEncoding is the encoding of the keys in otpauth URIs.
+var otpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPSecret represents the shared secret of time-based one-time passwords (RFC 6238) used for
+// two-factor authentication.
+//
+// Generate creates a random key and returns it encoded in base32, the format authenticator apps
+// expect when the key is typed. URI returns the otpauth:// URI usually shown as a QR code.
+type TOTPSecret struct {
+	// Shared key, set by Generate. It can be set to compute the codes of an existing secret.
+	Key []byte
+	// Number of bytes of the generated key, the output size of the hash function by default.
+	KeySize uint64
+	// Hash function used by HMAC, SHA1 by default.
+	Algorithm HashAlgorithm
+	// Number of digits of the codes, between 6 and 8. 6 by default.
+	Digits uint64
+	// Seconds each code is valid for, 30 by default.
+	Period uint64
+	// Provider or service the account belongs to, like "Acme".
+	Issuer string
+	// Name of the account, like "alice@example.com". Required by URI.
+	Account string
+}
+
+// Generate generates a random key, stores it in Key and returns it encoded in base32 without padding.
+func (t *TOTPSecret) Generate() ([]byte, error) {
+	key, err := t.generate()
+	if err != nil {
+		return nil, fmt.Errorf("atoll: %w", err)
+	}
+
+	return key, nil
+}
+
+func (t *TOTPSecret) generate() ([]byte, error) {
+	if err := t.validateParams(); err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, t.keySize())
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	t.Key = key
+
+	encoded := make([]byte, otpEncoding.EncodedLen(len(key)))
+	otpEncoding.Encode(encoded, key)
+	return encoded, nil
+}
+
+// Entropy returns the key entropy in bits.
+func (t *TOTPSecret) Entropy() float64 {
+	if t.Key != nil {
+		return float64(len(t.Key) * 8)
+	}
+	return float64(t.keySize() * 8)
+}
+
+// URI returns the otpauth:// provisioning URI of the secret, following the format understood by
+// most authenticator apps:
+//
+//	otpauth://totp/Acme:alice@example.com?secret=...&issuer=Acme&algorithm=SHA1&digits=6&period=30
+func (t *TOTPSecret) URI() (string, error) {
+	if err := t.validateKey(); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+	if t.Account == "" {
+		return "", errors.New("atoll: account is required")
+	}
+	// The colon separates the issuer from the account in the label
+	if strings.Contains(t.Issuer, ":") || strings.Contains(t.Account, ":") {
+		return "", errors.New("atoll: issuer and account cannot contain colons")
+	}
+
+	label := url.PathEscape(t.Account)
+	query := url.Values{}
+	query.Set("secret", otpEncoding.EncodeToString(t.Key))
+	if t.Issuer != "" {
+		label = url.PathEscape(t.Issuer) + ":" + label
+		query.Set("issuer", t.Issuer)
+	}
+	digits, period := t.params()
+	query.Set("algorithm", t.Algorithm.String())
+	query.Set("digits", strconv.Itoa(digits))
+	query.Set("period", strconv.FormatUint(period, 10))
+
+	// Authenticator apps don't decode "+" as a space, QueryEscape writes literal ones as %2B
+	return "otpauth://totp/" + label + "?" + strings.ReplaceAll(query.Encode(), "+", "%20"), nil
+}
+
+// HOTP returns the HMAC-based one-time password (RFC 4226) of the counter.
+func (t *TOTPSecret) HOTP(counter uint64) (string, error) {
+	if err := t.validateKey(); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	return t.hotp(counter), nil
+}
+
+// GenerateCode returns the time-based one-time password of the instant tm.
+func (t *TOTPSecret) GenerateCode(tm time.Time) (string, error) {
+	if err := t.validateKey(); err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+
+	counter, err := t.counter(tm)
+	if err != nil {
+		return "", fmt.Errorf("atoll: %w", err)
+	}
+	return t.hotp(counter), nil
+}
+
+// Validate reports whether the code is valid at the instant tm, accepting the codes of up to skew
+// periods before and after it to tolerate clock drift. Skews higher than 10 are lowered to 10, a
+// wider window makes guessing codes easier and RFC 6238 recommends at most one period.
+//
+// Every candidate is compared in constant time.
+func (t *TOTPSecret) Validate(code string, tm time.Time, skew uint64) bool {
+	if t.validateKey() != nil {
+		return false
+	}
+	counter, err := t.counter(tm)
+	if err != nil {
+		return false
+	}
+
+	skew = min(skew, maxTOTPSkew)
+	first := counter - min(counter, skew)
+	last := counter + min(math.MaxUint64-counter, skew)
+
+	valid := false
+	for c := first; ; c++ {
+		if Equal([]byte(t.hotp(c)), []byte(code)) {
+			valid = true
+		}
+		if c == last {
+			break
+		}
+	}
+	return valid
+}
+
+// hotp computes the code of the counter, the secret must have been validated.
+func (t *TOTPSecret) hotp(counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(t.Algorithm.new(), t.Key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0F
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7FFFFFFF
+
+	digits, _ := t.params()
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// counter returns the number of periods elapsed between the Unix epoch and tm.
+func (t *TOTPSecret) counter(tm time.Time) (uint64, error) {
+	if tm.Unix() < 0 {
+		return 0, errors.New("time cannot be before the Unix epoch")
+	}
+	_, period := t.params()
+	return uint64(tm.Unix()) / period, nil
+}
+
+// keySize returns the number of bytes of the generated key.
+func (t *TOTPSecret) keySize() uint64 {
+	if t.KeySize != 0 {
+		return t.KeySize
+	}
+	return uint64(t.Algorithm.new()().Size())
+}
+
+// params returns the number of digits and the period with their defaults.
+func (t *TOTPSecret) params() (digits int, period uint64) {
+	digits, period = 6, 30
+	if t.Digits != 0 {
+		digits = int(t.Digits)
+	}
+	if t.Period != 0 {
+		period = t.Period
+	}
+
+	return digits, period
+}
+
+func (t *TOTPSecret) validateKey() error {
+	if len(t.Key) == 0 {
+		return errors.New("the key is empty, call Generate or set it")
+	}
+	return t.validateParams()
+}
+
+func (t *TOTPSecret) validateParams() error {
+	if t.Algorithm > SHA512 {
+		return fmt.Errorf("invalid algorithm: %d", t.Algorithm)
+	}
+
+	// RFC 4226 requires keys of at least 128 bits
+	if t.KeySize != 0 && t.KeySize < 16 {
+		return fmt.Errorf("key size must be equal to or higher than 16 bytes, got %d", t.KeySize)
+	}
+
+	if digits, _ := t.params(); digits < 6 || digits > 8 {
+		return fmt.Errorf("digits must be between 6 and 8, got %d", digits)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package atoll
+
+import (
+	"math"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Seeds of the RFC 6238 test vectors.
+var (
+	seedSHA1   = []byte("12345678901234567890")
+	seedSHA256 = []byte("12345678901234567890123456789012")
+	seedSHA512 = []byte("1234567890123456789012345678901234567890123456789012345678901234")
+)
+
+func TestHOTP(t *testing.T) {
+	// RFC 4226 appendix D
+	expected := []string{
+		"755224", "287082", "359152", "969429", "338314",
+		"254676", "287922", "162583", "399871", "520489",
+	}
+
+	secret := &TOTPSecret{Key: seedSHA1}
+	for counter, code := range expected {
+		got, err := secret.HOTP(uint64(counter))
+		if err != nil {
+			t.Fatalf("HOTP() failed: %v", err)
+		}
+		if got != code {
+			t.Errorf("Counter %d: expected %q, got %q", counter, code, got)
+		}
+	}
+}
+
+func TestTOTPGenerateCode(t *testing.T) {
+	// RFC 6238 appendix B
+	cases := []struct {
+		time                 int64
+		sha1, sha256, sha512 string
+	}{
+		{time: 59, sha1: "94287082", sha256: "46119246", sha512: "90693936"},
+		{time: 1111111109, sha1: "07081804", sha256: "68084774", sha512: "25091201"},
+		{time: 1111111111, sha1: "14050471", sha256: "67062674", sha512: "99943326"},
+		{time: 1234567890, sha1: "89005924", sha256: "91819424", sha512: "93441116"},
+		{time: 2000000000, sha1: "69279037", sha256: "90698825", sha512: "38618901"},
+		{time: 20000000000, sha1: "65353130", sha256: "77737706", sha512: "47863826"},
+	}
+
+	secrets := []*TOTPSecret{
+		{Key: seedSHA1, Algorithm: SHA1, Digits: 8},
+		{Key: seedSHA256, Algorithm: SHA256, Digits: 8},
+		{Key: seedSHA512, Algorithm: SHA512, Digits: 8},
+	}
+
+	for _, tc := range cases {
+		for i, expected := range []string{tc.sha1, tc.sha256, tc.sha512} {
+			got, err := secrets[i].GenerateCode(time.Unix(tc.time, 0))
+			if err != nil {
+				t.Fatalf("GenerateCode() failed: %v", err)
+			}
+			if got != expected {
+				t.Errorf("%s at %d: expected %q, got %q", secrets[i].Algorithm, tc.time, expected, got)
+			}
+		}
+	}
+}
+
+func TestTOTPValidate(t *testing.T) {
+	secret := &TOTPSecret{}
+	if _, err := secret.Generate(); err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	code, err := secret.GenerateCode(now)
+	if err != nil {
+		t.Fatalf("GenerateCode() failed: %v", err)
+	}
+
+	cases := []struct {
+		desc     string
+		code     string
+		tm       time.Time
+		skew     uint64
+		expected bool
+	}{
+		{desc: "same period", code: code, tm: now, expected: true},
+		{desc: "next period without skew", code: code, tm: now.Add(30 * time.Second)},
+		{desc: "next period with skew", code: code, tm: now.Add(30 * time.Second), skew: 1, expected: true},
+		{desc: "previous period with skew", code: code, tm: now.Add(-30 * time.Second), skew: 1, expected: true},
+		{desc: "two periods with skew 1", code: code, tm: now.Add(60 * time.Second), skew: 1},
+		{desc: "wrong code", code: "12345", tm: now, skew: 1},
+		{desc: "before epoch", code: code, tm: time.Unix(-100, 0), skew: 1},
+		{desc: "maximum skew", code: code, tm: now.Add(10 * 30 * time.Second), skew: 20, expected: true},
+		{desc: "beyond maximum skew", code: code, tm: now.Add(11 * 30 * time.Second), skew: 20},
+		{desc: "huge skew", code: code, tm: now, skew: math.MaxUint64, expected: true},
+	}
+
+	for _, tc := range cases {
+		if got := secret.Validate(tc.code, tc.tm, tc.skew); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.desc, tc.expected, got)
+		}
+	}
+
+	// Skew larger than the counter
+	secret = &TOTPSecret{Key: seedSHA1, Digits: 8}
+	if !secret.Validate("94287082", time.Unix(59, 0), 5) {
+		t.Error("Expected code to be valid")
+	}
+}
+
+func TestTOTPGenerate(t *testing.T) {
+	cases := []struct {
+		secret  *TOTPSecret
+		keySize int
+	}{
+		{secret: &TOTPSecret{}, keySize: 20},
+		{secret: &TOTPSecret{Algorithm: SHA256}, keySize: 32},
+		{secret: &TOTPSecret{Algorithm: SHA512}, keySize: 64},
+		{secret: &TOTPSecret{KeySize: 16}, keySize: 16},
+	}
+
+	for _, tc := range cases {
+		encoded, err := tc.secret.Generate()
+		if err != nil {
+			t.Fatalf("Generate() failed: %v", err)
+		}
+
+		if len(tc.secret.Key) != tc.keySize {
+			t.Errorf("Expected key size to be %d, got %d", tc.keySize, len(tc.secret.Key))
+		}
+		if got := otpEncoding.EncodeToString(tc.secret.Key); got != string(encoded) {
+			t.Errorf("Expected %q, got %q", got, encoded)
+		}
+		if strings.Contains(string(encoded), "=") {
+			t.Errorf("Encoded key %q is padded", encoded)
+		}
+		if got := tc.secret.Entropy(); got != float64(tc.keySize*8) {
+			t.Errorf("Expected %d, got %f", tc.keySize*8, got)
+		}
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	secret := &TOTPSecret{
+		Key:     seedSHA1,
+		Issuer:  "Acme Co",
+		Account: "alice@example.com",
+	}
+
+	uri, err := secret.URI()
+	if err != nil {
+		t.Fatalf("URI() failed: %v", err)
+	}
+
+	expected := "otpauth://totp/Acme%20Co:alice@example.com?algorithm=SHA1&digits=6" +
+		"&issuer=Acme%20Co&period=30&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	if uri != expected {
+		t.Errorf("Expected %q, got %q", expected, uri)
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("Failed parsing URI: %v", err)
+	}
+	if u.Host != "totp" || u.Path != "/Acme Co:alice@example.com" || u.Query().Get("issuer") != "Acme Co" {
+		t.Errorf("Invalid URI %q", uri)
+	}
+}
+
+func TestInvalidTOTPSecret(t *testing.T) {
+	cases := map[string]*TOTPSecret{
+		"invalid algorithm": {Algorithm: 3},
+		"short key":         {KeySize: 10},
+		"few digits":        {Digits: 5},
+		"many digits":       {Digits: 9},
+	}
+
+	for name, tc := range cases {
+		if _, err := tc.Generate(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	uriCases := map[string]*TOTPSecret{
+		"empty key":       {Account: "alice"},
+		"missing account": {Key: seedSHA1},
+		"colon":           {Key: seedSHA1, Issuer: "Acme:Co", Account: "alice"},
+	}
+
+	for name, tc := range uriCases {
+		if _, err := tc.URI(); err == nil {
+			t.Errorf("Expected %q error, got nil", name)
+		}
+	}
+
+	if _, err := (&TOTPSecret{}).GenerateCode(time.Now()); err == nil {
+		t.Error("Expected empty key error, got nil")
+	}
+	if _, err := (&TOTPSecret{Key: seedSHA1}).GenerateCode(time.Unix(-1, 0)); err == nil {
+		t.Error("Expected time error, got nil")
+	}
+}
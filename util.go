@@ -3,6 +3,9 @@ package atoll
 import (
 	"bytes"
 	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"regexp"
@@ -40,6 +43,75 @@ func getFuncName(f list) string {
 	return fn[lastDot+1:]
 }
 
+// levelEntropy returns the entropy in bits of a random character of the level.
+//
+// Repeated characters are more likely to be selected and are taken into account.
+func levelEntropy(lvl Level) float64 {
+	var counts [256]int
+	for i := 0; i < len(lvl); i++ {
+		counts[lvl[i]]++
+	}
+
+	var entropy float64
+	for _, n := range counts {
+		if n > 0 {
+			prob := float64(n) / float64(len(lvl))
+			entropy -= prob * math.Log2(prob)
+		}
+	}
+
+	return entropy
+}
+
+// lower returns the lowercase version of the ASCII character c.
+func lower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// upper returns the uppercase version of the ASCII character c.
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
+
+// toLower converts the ASCII letters of word to lowercase in place.
+func toLower(word []byte) {
+	for i, c := range word {
+		word[i] = lower(c)
+	}
+}
+
+// toUpper converts the ASCII letters of word to uppercase in place.
+func toUpper(word []byte) {
+	for i, c := range word {
+		word[i] = upper(c)
+	}
+}
+
+// toTitle capitalizes the first letter of word and converts the rest to lowercase in place.
+func toTitle(word []byte) {
+	toLower(word)
+	if len(word) > 0 {
+		word[0] = upper(word[0])
+	}
+}
+
+// letterCount returns the number of ASCII letters in word.
+func letterCount(word []byte) int {
+	n := 0
+	for _, c := range word {
+		if lower(c) != upper(c) {
+			n++
+		}
+	}
+	return n
+}
+
 // randInt returns a cryptographically secure random integer in [0, max).
 func randInt(max int) int64 {
 	// The error is skipped as max is always > 0.
@@ -47,6 +119,11 @@ func randInt(max int) int64 {
 	return randN.Int64()
 }
 
+// randFloat returns a cryptographically secure random float in [0, 1).
+func randFloat() float64 {
+	return float64(randInt(1<<53)) / (1 << 53)
+}
+
 // shuffle changes randomly the order of the password elements.
 func shuffle(key []byte) []byte {
 	for i := range key {
@@ -56,3 +133,148 @@ func shuffle(key []byte) []byte {
 
 	return key
 }
+
+// validateLengthRange checks that the range [min, max] and its weights are valid.
+//
+// A max of 0 means that no range is used.
+func validateLengthRange(min, max uint64, weights []float64) error {
+	if max == 0 {
+		if min != 0 {
+			return errors.New("minimum length requires a maximum length")
+		}
+		if len(weights) != 0 {
+			return errors.New("length weights require a length range")
+		}
+		return nil
+	}
+
+	if min < 1 {
+		return errors.New("minimum length must be equal to or higher than 1")
+	}
+
+	if min > max {
+		return errors.New("minimum length is higher than the maximum length")
+	}
+
+	if len(weights) == 0 {
+		return nil
+	}
+
+	if uint64(len(weights)) != max-min+1 {
+		return fmt.Errorf("expected %d length weights, got %d", max-min+1, len(weights))
+	}
+
+	var total float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return fmt.Errorf("invalid length weight: %v", w)
+		}
+		total += w
+	}
+
+	if total == 0 {
+		return errors.New("at least one length weight must be higher than zero")
+	}
+
+	return nil
+}
+
+// lengthProbabilities returns the probability of each length in [min, max], lengths are
+// equally likely if weights is empty.
+func lengthProbabilities(min, max uint64, weights []float64) []float64 {
+	probs := make([]float64, max-min+1)
+	if len(weights) == 0 {
+		for i := range probs {
+			probs[i] = 1 / float64(len(probs))
+		}
+		return probs
+	}
+
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	for i, w := range weights {
+		probs[i] = w / total
+	}
+
+	return probs
+}
+
+// randLength returns a random length in [min, max] drawn using the weights provided.
+func randLength(min, max uint64, weights []float64) uint64 {
+	if len(weights) == 0 {
+		return min + uint64(randInt(int(max-min+1)))
+	}
+
+	probs := lengthProbabilities(min, max, weights)
+	r := randFloat()
+	for i, prob := range probs {
+		if r < prob {
+			return min + uint64(i)
+		}
+		r -= prob
+	}
+
+	// Rounding errors, use the last length with a positive probability
+	for i := len(probs) - 1; i >= 0; i-- {
+		if probs[i] > 0 {
+			return min + uint64(i)
+		}
+	}
+
+	return max
+}
+
+// rangeEntropy returns the entropy of a secret whose length is drawn from [min, max], being
+// entropy the function that returns the entropy of a secret of a fixed length.
+//
+// It's the entropy of the length plus the expected entropy of the secret given its length, so
+// unlikely lengths add little even if they are long.
+func rangeEntropy(min, max uint64, weights []float64, entropy func(length uint64) float64) float64 {
+	var total float64
+	for i, prob := range lengthProbabilities(min, max, weights) {
+		if prob == 0 {
+			continue
+		}
+		total += prob * (entropy(min+uint64(i)) - math.Log2(prob))
+	}
+
+	return total
+}
+
+// closestWord returns the word of the list with the lowest edit distance to word, ignoring the case.
+func closestWord(word string, list [][]byte) string {
+	var closest []byte
+	best := -1
+	for _, w := range list {
+		if d := levenshtein(word, strings.ToLower(string(w))); best == -1 || d < best {
+			closest, best = w, d
+		}
+	}
+	return strings.ToLower(string(closest))
+}
+
+// levenshtein returns the minimum number of single character insertions, deletions and
+// substitutions required to change a into b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
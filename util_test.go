@@ -46,3 +46,91 @@ func TestShuffle(t *testing.T) {
 		t.Errorf("Expected something different, got: %s", password)
 	}
 }
+
+func TestValidateLengthRange(t *testing.T) {
+	cases := map[string]struct {
+		weights  []float64
+		min, max uint64
+		fail     bool
+	}{
+		"no range":           {min: 0, max: 0},
+		"range":              {min: 4, max: 8},
+		"weighted range":     {min: 1, max: 3, weights: []float64{1, 0, 2}},
+		"min without max":    {min: 3, fail: true},
+		"weights only":       {weights: []float64{1}, fail: true},
+		"min zero":           {min: 0, max: 5, fail: true},
+		"min higher":         {min: 6, max: 5, fail: true},
+		"weights length":     {min: 1, max: 3, weights: []float64{1, 2}, fail: true},
+		"negative weight":    {min: 1, max: 2, weights: []float64{1, -1}, fail: true},
+		"all weights zeroed": {min: 1, max: 2, weights: []float64{0, 0}, fail: true},
+	}
+
+	for k, tc := range cases {
+		err := validateLengthRange(tc.min, tc.max, tc.weights)
+		if tc.fail && err == nil {
+			t.Errorf("%s: expected an error, got nil", k)
+		}
+		if !tc.fail && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+	}
+}
+
+func TestRandLength(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if got := randLength(5, 9, nil); got < 5 || got > 9 {
+			t.Errorf("Length %d is out of range", got)
+		}
+		if got := randLength(5, 7, []float64{0, 3, 0}); got != 6 {
+			t.Errorf("Expected 6, got %d", got)
+		}
+	}
+}
+
+func TestRangeEntropy(t *testing.T) {
+	// One bit per character
+	entropy := func(length uint64) float64 { return float64(length) }
+
+	// Length adds 1 bit and the expected length is 1.5
+	if got := rangeEntropy(1, 2, nil, entropy); got != 2.5 {
+		t.Errorf("Expected 2.5, got %f", got)
+	}
+
+	// Lengths that can't be drawn are not counted
+	if got := rangeEntropy(1, 3, []float64{0, 0, 1}, entropy); got != 3 {
+		t.Errorf("Expected 3, got %f", got)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "", b: "abc", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "flaw", b: "lawn", expected: 2},
+		{a: "same", b: "same", expected: 0},
+	}
+
+	for _, tc := range cases {
+		if got := levenshtein(tc.a, tc.b); got != tc.expected {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
+func TestClosestWord(t *testing.T) {
+	list := [][]byte{[]byte("Apple"), []byte("banana"), []byte("cherry")}
+	cases := map[string]string{
+		"aple":   "apple",
+		"bananq": "banana",
+		"chery":  "cherry",
+	}
+
+	for word, expected := range cases {
+		if got := closestWord(word, list); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	}
+}